@@ -2,6 +2,7 @@
 package sigsci
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -13,82 +14,178 @@ import (
 	"time"
 )
 
-const apiURL = "https://dashboard.signalsciences.net/api"
+const defaultAPIURL = "https://dashboard.signalsciences.net/api"
 
 // Client is the API client
 type Client struct {
-	email string
-	token string
+	email      string
+	token      string
+	baseURL    string
+	userAgent  string
+	timeout    time.Duration
+	httpClient *http.Client
+	retry      RetryPolicy
+
+	limiter       *RateLimiter
+	classLimiters map[EndpointClass]*RateLimiter
+
+	// session is set for clients created with NewClient, which log in
+	// again when their session token expires.
+	session *session
+}
+
+// NewClient authenticates and returns a Client API client. When the
+// session token expires, the client logs in again with the same email and
+// password and retries the call.
+func NewClient(email, password string, opts ...ClientOption) (Client, error) {
+	return NewClientContext(context.Background(), email, password, opts...)
 }
 
-// NewClient authenticates and returns a Client API client
-func NewClient(email, password string) (Client, error) {
-	sc := Client{}
-	err := sc.authenticate(email, password)
+// NewClientContext is like NewClient but uses the given context for the
+// authentication request.
+func NewClientContext(ctx context.Context, email, password string, opts ...ClientOption) (Client, error) {
+	sc := newClient(opts)
+	token, err := sc.authenticate(ctx, email, password)
 	if err != nil {
 		return Client{}, err
 	}
+	sc.session = &session{email: email, password: password, token: token}
 
 	return sc, nil
 }
 
 // NewTokenClient creates a Client using token authentication
-func NewTokenClient(email, token string) Client {
-	return Client{
-		email: email,
-		token: token,
-	}
+func NewTokenClient(email, token string, opts ...ClientOption) Client {
+	sc := newClient(opts)
+	sc.email = email
+	sc.token = token
+
+	return sc
 }
 
-// authenticate takes email/password and authenticates, attaching the
-// returned token to the API client.
-func (sc *Client) authenticate(email, password string) error {
-	resp, err := http.PostForm(apiURL+"/v0/auth", url.Values{"email": {email}, "password": {password}})
+// authenticate takes email/password and authenticates, returning the
+// session token.
+func (sc *Client) authenticate(ctx context.Context, email, password string) (string, error) {
+	ctx, cancel := sc.withTimeout(ctx)
+	defer cancel()
+
+	form := url.Values{"email": {email}, "password": {password}}
+	req, err := http.NewRequest("POST", sc.apiURL()+"/v0/auth", strings.NewReader(form.Encode()))
 	if err != nil {
-		return err
+		return "", err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("User-Agent", sc.userAgentString())
+
+	resp, err := sc.client().Do(req)
+	if err != nil {
+		return "", err
 	}
 
 	defer resp.Body.Close()
 
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", newAPIError(resp, "POST", "/v0/auth", body)
+	}
+
 	var tr struct {
 		Token string
 	}
 
-	err = json.NewDecoder(resp.Body).Decode(&tr)
+	err = json.Unmarshal(body, &tr)
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	sc.token = tr.Token
+	return tr.Token, nil
+}
 
-	return nil
+func (sc *Client) doRequest(ctx context.Context, method, url, reqBody string) ([]byte, error) {
+	ctx, cancel := sc.withTimeout(ctx)
+	defer cancel()
+
+	for attempt := 1; ; attempt++ {
+		body, err := sc.doRequestAuth(ctx, method, url, reqBody)
+		if err == nil {
+			return body, nil
+		}
+
+		wait, ok := sc.retry.backoff(ctx, method, attempt, err)
+		if !ok {
+			return body, err
+		}
+
+		if sc.retry.OnRetry != nil {
+			sc.retry.OnRetry(RetryInfo{
+				Attempt: attempt,
+				Method:  method,
+				Path:    url,
+				Wait:    wait,
+				Err:     err,
+			})
+		}
+
+		if err := sleepContext(ctx, wait); err != nil {
+			return body, err
+		}
+	}
+}
+
+// doRequestAuth is like doRequestOnce, but if the session token of a
+// password client has expired, it logs in again and retries once.
+func (sc *Client) doRequestAuth(ctx context.Context, method, url, reqBody string) ([]byte, error) {
+	token, gen := sc.sessionToken()
+	body, err := sc.doRequestOnce(ctx, method, url, reqBody, token)
+	if sc.session == nil || !IsUnauthorized(err) {
+		return body, err
+	}
+
+	retry, rerr := sc.reauthenticate(ctx, gen)
+	if rerr != nil {
+		return body, rerr
+	}
+	if !retry {
+		return body, err
+	}
+	token, _ = sc.sessionToken()
+
+	return sc.doRequestOnce(ctx, method, url, reqBody, token)
 }
 
-func (sc *Client) doRequest(method, url, reqBody string) ([]byte, error) {
-	client := &http.Client{}
+func (sc *Client) doRequestOnce(ctx context.Context, method, url, reqBody, token string) ([]byte, error) {
+	if err := sc.waitRateLimit(ctx, url); err != nil {
+		return []byte{}, err
+	}
 
 	var b io.Reader
 	if reqBody != "" {
 		b = strings.NewReader(reqBody)
 	}
 
-	req, err := http.NewRequest(method, apiURL+url, b)
+	req, err := http.NewRequest(method, sc.apiURL()+url, b)
 	if err != nil {
 		return []byte{}, err
 	}
+	req = req.WithContext(ctx)
 
 	if sc.email != "" {
 		// token auth
 		req.Header.Set("X-API-User", sc.email)
-		req.Header.Set("X-API-Token", sc.token)
+		req.Header.Set("X-API-Token", token)
 	} else {
-		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", sc.token))
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
 	}
 
 	req.Header.Add("Content-Type", "application/json")
-	req.Header.Set("User-Agent", "go-sigsci")
+	req.Header.Set("User-Agent", sc.userAgentString())
 
-	resp, err := client.Do(req)
+	resp, err := sc.client().Do(req)
 	if err != nil {
 		return []byte{}, err
 	}
@@ -102,40 +199,27 @@ func (sc *Client) doRequest(method, url, reqBody string) ([]byte, error) {
 	switch method {
 	case "GET":
 		if resp.StatusCode != http.StatusOK {
-			return body, errMsg(body)
+			return body, newAPIError(resp, method, url, body)
 		}
 	case "POST":
 		switch resp.StatusCode {
 		case http.StatusOK, http.StatusCreated, http.StatusNoContent:
 		default:
-			return body, errMsg(body)
+			return body, newAPIError(resp, method, url, body)
 		}
 	case "DELETE":
 		if resp.StatusCode != http.StatusNoContent {
-			return body, errMsg(body)
+			return body, newAPIError(resp, method, url, body)
 		}
-	case "PATCH":
+	case "PATCH", "PUT":
 		if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-			return body, errMsg(body)
+			return body, newAPIError(resp, method, url, body)
 		}
 	}
 
 	return body, nil
 }
 
-func errMsg(b []byte) error {
-	var errResp struct {
-		Message string
-	}
-
-	err := json.Unmarshal(b, &errResp)
-	if err != nil {
-		return err
-	}
-
-	return errors.New(errResp.Message)
-}
-
 // Corp contains details for a corp.
 type Corp struct {
 	Name                   string
@@ -156,7 +240,12 @@ type corpsResponse struct {
 
 // ListCorps lists corps.
 func (sc *Client) ListCorps() ([]Corp, error) {
-	resp, err := sc.doRequest("GET", "/v0/corps", "")
+	return sc.ListCorpsContext(context.Background())
+}
+
+// ListCorpsContext is like ListCorps but uses the given context.
+func (sc *Client) ListCorpsContext(ctx context.Context) ([]Corp, error) {
+	resp, err := sc.doRequest(ctx, "GET", "/v0/corps", "")
 	if err != nil {
 		return []Corp{}, err
 	}
@@ -172,7 +261,12 @@ func (sc *Client) ListCorps() ([]Corp, error) {
 
 // GetCorp gets a corp by name.
 func (sc *Client) GetCorp(corpName string) (Corp, error) {
-	resp, err := sc.doRequest("GET", fmt.Sprintf("/v0/corps/%s", corpName), "")
+	return sc.GetCorpContext(context.Background(), corpName)
+}
+
+// GetCorpContext is like GetCorp but uses the given context.
+func (sc *Client) GetCorpContext(ctx context.Context, corpName string) (Corp, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s", corpName), "")
 	if err != nil {
 		return Corp{}, err
 	}
@@ -187,20 +281,26 @@ func (sc *Client) GetCorp(corpName string) (Corp, error) {
 }
 
 // UpdateCorpBody is the body for the UpdateCorp method.
+// Nil fields are left unchanged; use String and Int to set them.
 type UpdateCorpBody struct {
-	DisplayName            string `json:"displayName,omitempty"`
-	SmallIconURI           string `json:"smallIconURI,omitempty"`
-	SessionMaxAgeDashboard int    `json:"sessionMaxAgeDashboard,omitempty"`
+	DisplayName            *string `json:"displayName,omitempty"`
+	SmallIconURI           *string `json:"smallIconURI,omitempty"`
+	SessionMaxAgeDashboard *int    `json:"sessionMaxAgeDashboard,omitempty"`
 }
 
 // UpdateCorp updates a corp by name.
 func (sc *Client) UpdateCorp(corpName string, body UpdateCorpBody) (Corp, error) {
+	return sc.UpdateCorpContext(context.Background(), corpName, body)
+}
+
+// UpdateCorpContext is like UpdateCorp but uses the given context.
+func (sc *Client) UpdateCorpContext(ctx context.Context, corpName string, body UpdateCorpBody) (Corp, error) {
 	b, err := json.Marshal(body)
 	if err != nil {
 		return Corp{}, err
 	}
 
-	resp, err := sc.doRequest("PATCH", fmt.Sprintf("/v0/corps/%s", corpName), string(b))
+	resp, err := sc.doRequest(ctx, "PATCH", fmt.Sprintf("/v0/corps/%s", corpName), string(b))
 	if err != nil {
 		return Corp{}, err
 	}
@@ -256,7 +356,12 @@ type corpUsersResponse struct {
 
 // ListCorpUsers lists corp users.
 func (sc *Client) ListCorpUsers(corpName string) ([]CorpUser, error) {
-	resp, err := sc.doRequest("GET", fmt.Sprintf("/v0/corps/%s/users", corpName), "")
+	return sc.ListCorpUsersContext(context.Background(), corpName)
+}
+
+// ListCorpUsersContext is like ListCorpUsers but uses the given context.
+func (sc *Client) ListCorpUsersContext(ctx context.Context, corpName string) ([]CorpUser, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/users", corpName), "")
 	if err != nil {
 		return []CorpUser{}, err
 	}
@@ -272,7 +377,12 @@ func (sc *Client) ListCorpUsers(corpName string) ([]CorpUser, error) {
 
 // GetCorpUser gets a corp user by email.
 func (sc *Client) GetCorpUser(corpName, email string) (CorpUser, error) {
-	resp, err := sc.doRequest("GET", fmt.Sprintf("/v0/corps/%s/users/%s", corpName, email), "")
+	return sc.GetCorpUserContext(context.Background(), corpName, email)
+}
+
+// GetCorpUserContext is like GetCorpUser but uses the given context.
+func (sc *Client) GetCorpUserContext(ctx context.Context, corpName, email string) (CorpUser, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/users/%s", corpName, email), "")
 	if err != nil {
 		return CorpUser{}, err
 	}
@@ -288,7 +398,12 @@ func (sc *Client) GetCorpUser(corpName, email string) (CorpUser, error) {
 
 // DeleteCorpUser deletes a user from the given corp.
 func (sc *Client) DeleteCorpUser(corpName, email string) error {
-	_, err := sc.doRequest("DELETE", fmt.Sprintf("/v0/corps/%s/users/%s", corpName, email), "")
+	return sc.DeleteCorpUserContext(context.Background(), corpName, email)
+}
+
+// DeleteCorpUserContext is like DeleteCorpUser but uses the given context.
+func (sc *Client) DeleteCorpUserContext(ctx context.Context, corpName, email string) error {
+	_, err := sc.doRequest(ctx, "DELETE", fmt.Sprintf("/v0/corps/%s/users/%s", corpName, email), "")
 
 	return err
 }
@@ -334,12 +449,44 @@ func NewCorpUserInvite(corpRole Role, memberships []SiteMembership) CorpUserInvi
 
 // InviteUser invites a user by email to a corp.
 func (sc *Client) InviteUser(corpName, email string, invite CorpUserInvite) (CorpUser, error) {
+	return sc.InviteUserContext(context.Background(), corpName, email, invite)
+}
+
+// InviteUserContext is like InviteUser but uses the given context.
+func (sc *Client) InviteUserContext(ctx context.Context, corpName, email string, invite CorpUserInvite) (CorpUser, error) {
 	body, err := json.Marshal(invite)
 	if err != nil {
 		return CorpUser{}, err
 	}
 
-	resp, err := sc.doRequest("POST", fmt.Sprintf("/v0/corps/%s/users/%s/invite", corpName, email), string(body))
+	resp, err := sc.doRequest(ctx, "POST", fmt.Sprintf("/v0/corps/%s/users/%s/invite", corpName, email), string(body))
+	if err != nil {
+		return CorpUser{}, err
+	}
+
+	var cu CorpUser
+	err = json.Unmarshal(resp, &cu)
+	if err != nil {
+		return CorpUser{}, err
+	}
+
+	return cu, nil
+}
+
+// UpdateCorpUser updates the corp role and site memberships of a corp
+// user by email.
+func (sc *Client) UpdateCorpUser(corpName, email string, body CorpUserInvite) (CorpUser, error) {
+	return sc.UpdateCorpUserContext(context.Background(), corpName, email, body)
+}
+
+// UpdateCorpUserContext is like UpdateCorpUser but uses the given context.
+func (sc *Client) UpdateCorpUserContext(ctx context.Context, corpName, email string, body CorpUserInvite) (CorpUser, error) {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return CorpUser{}, err
+	}
+
+	resp, err := sc.doRequest(ctx, "PATCH", fmt.Sprintf("/v0/corps/%s/users/%s", corpName, email), string(b))
 	if err != nil {
 		return CorpUser{}, err
 	}
@@ -386,11 +533,16 @@ type overviewResponse struct {
 
 // GetOverviewReport gets the overview report data for a given corp.
 func (sc *Client) GetOverviewReport(corpName string, query url.Values) ([]OverviewSite, error) {
+	return sc.GetOverviewReportContext(context.Background(), corpName, query)
+}
+
+// GetOverviewReportContext is like GetOverviewReport but uses the given context.
+func (sc *Client) GetOverviewReportContext(ctx context.Context, corpName string, query url.Values) ([]OverviewSite, error) {
 	url := fmt.Sprintf("/v0/corps/%s/reports/attacks", corpName)
 	if query.Encode() != "" {
 		url += "?" + query.Encode()
 	}
-	resp, err := sc.doRequest("GET", url, "")
+	resp, err := sc.doRequest(ctx, "GET", url, "")
 	if err != nil {
 		return []OverviewSite{}, err
 	}
@@ -423,7 +575,12 @@ type activityResponse struct {
 
 // ListCorpActivity lists activity events for a given corp.
 func (sc *Client) ListCorpActivity(corpName string, limit, page int) ([]ActivityEvent, error) {
-	resp, err := sc.doRequest("GET", fmt.Sprintf("/v0/corps/%s/activity?limit=%d&page=%d", corpName, limit, page), "")
+	return sc.ListCorpActivityContext(context.Background(), corpName, limit, page)
+}
+
+// ListCorpActivityContext is like ListCorpActivity but uses the given context.
+func (sc *Client) ListCorpActivityContext(ctx context.Context, corpName string, limit, page int) ([]ActivityEvent, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/activity?limit=%d&page=%d", corpName, limit, page), "")
 	if err != nil {
 		return []ActivityEvent{}, err
 	}
@@ -470,7 +627,12 @@ type sitesResponse struct {
 
 // ListSites lists sites for a given corp.
 func (sc *Client) ListSites(corpName string) ([]Site, error) {
-	resp, err := sc.doRequest("GET", fmt.Sprintf("/v0/corps/%s/sites", corpName), "")
+	return sc.ListSitesContext(context.Background(), corpName)
+}
+
+// ListSitesContext is like ListSites but uses the given context.
+func (sc *Client) ListSitesContext(ctx context.Context, corpName string) ([]Site, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/sites", corpName), "")
 	if err != nil {
 		return []Site{}, err
 	}
@@ -486,7 +648,12 @@ func (sc *Client) ListSites(corpName string) ([]Site, error) {
 
 // GetSite gets a site by name.
 func (sc *Client) GetSite(corpName, siteName string) (Site, error) {
-	resp, err := sc.doRequest("GET", fmt.Sprintf("/v0/corps/%s/sites/%s", corpName, siteName), "")
+	return sc.GetSiteContext(context.Background(), corpName, siteName)
+}
+
+// GetSiteContext is like GetSite but uses the given context.
+func (sc *Client) GetSiteContext(ctx context.Context, corpName, siteName string) (Site, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/sites/%s", corpName, siteName), "")
 	if err != nil {
 		return Site{}, err
 	}
@@ -501,20 +668,62 @@ func (sc *Client) GetSite(corpName, siteName string) (Site, error) {
 }
 
 // UpdateSiteBody is the body for the update site method.
+// Nil fields are left unchanged; use String and Int to set them.
 type UpdateSiteBody struct {
-	DisplayName          string `json:"displayName,omitempty"`
-	AgentLevel           string `json:"agentLevel,omitempty"`
-	BlockDurationSeconds int    `json:"blockDurationSeconds,omitempty"`
+	DisplayName          *string `json:"displayName,omitempty"`
+	AgentLevel           *string `json:"agentLevel,omitempty"`
+	BlockHTTPCode        *int    `json:"blockHTTPCode,omitempty"`
+	BlockDurationSeconds *int    `json:"blockDurationSeconds,omitempty"`
 }
 
 // UpdateSite updates a site by name.
 func (sc *Client) UpdateSite(corpName, siteName string, body UpdateSiteBody) (Site, error) {
+	return sc.UpdateSiteContext(context.Background(), corpName, siteName, body)
+}
+
+// UpdateSiteContext is like UpdateSite but uses the given context.
+func (sc *Client) UpdateSiteContext(ctx context.Context, corpName, siteName string, body UpdateSiteBody) (Site, error) {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return Site{}, err
+	}
+
+	resp, err := sc.doRequest(ctx, "PATCH", fmt.Sprintf("/v0/corps/%s/sites/%s", corpName, siteName), string(b))
+	if err != nil {
+		return Site{}, err
+	}
+
+	var site Site
+	err = json.Unmarshal(resp, &site)
+	if err != nil {
+		return Site{}, err
+	}
+
+	return site, nil
+}
+
+// CreateSiteBody is the body for the create site method.
+type CreateSiteBody struct {
+	Name                 string `json:"name"`
+	DisplayName          string `json:"displayName"`
+	AgentLevel           string `json:"agentLevel,omitempty"`
+	BlockHTTPCode        int    `json:"blockHTTPCode,omitempty"`
+	BlockDurationSeconds int    `json:"blockDurationSeconds,omitempty"`
+}
+
+// CreateSite creates a site in the given corp.
+func (sc *Client) CreateSite(corpName string, body CreateSiteBody) (Site, error) {
+	return sc.CreateSiteContext(context.Background(), corpName, body)
+}
+
+// CreateSiteContext is like CreateSite but uses the given context.
+func (sc *Client) CreateSiteContext(ctx context.Context, corpName string, body CreateSiteBody) (Site, error) {
 	b, err := json.Marshal(body)
 	if err != nil {
 		return Site{}, err
 	}
 
-	resp, err := sc.doRequest("PATCH", fmt.Sprintf("/v0/corps/%s/sites/%s", corpName, siteName), string(b))
+	resp, err := sc.doRequest(ctx, "POST", fmt.Sprintf("/v0/corps/%s/sites", corpName), string(b))
 	if err != nil {
 		return Site{}, err
 	}
@@ -528,6 +737,18 @@ func (sc *Client) UpdateSite(corpName, siteName string, body UpdateSiteBody) (Si
 	return site, nil
 }
 
+// DeleteSite deletes a site by name.
+func (sc *Client) DeleteSite(corpName, siteName string) error {
+	return sc.DeleteSiteContext(context.Background(), corpName, siteName)
+}
+
+// DeleteSiteContext is like DeleteSite but uses the given context.
+func (sc *Client) DeleteSiteContext(ctx context.Context, corpName, siteName string) error {
+	_, err := sc.doRequest(ctx, "DELETE", fmt.Sprintf("/v0/corps/%s/sites/%s", corpName, siteName), "")
+
+	return err
+}
+
 // CustomAlert contains the data for a custom alert
 type CustomAlert struct {
 	ID        string
@@ -547,7 +768,12 @@ type customAlertsResponse struct {
 
 // ListCustomAlerts lists custom alerts for a given corp and site.
 func (sc *Client) ListCustomAlerts(corpName, siteName string) ([]CustomAlert, error) {
-	resp, err := sc.doRequest("GET", fmt.Sprintf("/v0/corps/%s/sites/%s/alerts", corpName, siteName), "")
+	return sc.ListCustomAlertsContext(context.Background(), corpName, siteName)
+}
+
+// ListCustomAlertsContext is like ListCustomAlerts but uses the given context.
+func (sc *Client) ListCustomAlertsContext(ctx context.Context, corpName, siteName string) ([]CustomAlert, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/sites/%s/alerts", corpName, siteName), "")
 	if err != nil {
 		return []CustomAlert{}, err
 	}
@@ -573,12 +799,17 @@ type CustomAlertBody struct {
 
 // CreateCustomAlert creates a custom alert.
 func (sc *Client) CreateCustomAlert(corpName, siteName string, body CustomAlertBody) (CustomAlert, error) {
+	return sc.CreateCustomAlertContext(context.Background(), corpName, siteName, body)
+}
+
+// CreateCustomAlertContext is like CreateCustomAlert but uses the given context.
+func (sc *Client) CreateCustomAlertContext(ctx context.Context, corpName, siteName string, body CustomAlertBody) (CustomAlert, error) {
 	b, err := json.Marshal(body)
 	if err != nil {
 		return CustomAlert{}, err
 	}
 
-	resp, err := sc.doRequest("PATCH", fmt.Sprintf("/v0/corps/%s/sites/%s/alerts", corpName, siteName), string(b))
+	resp, err := sc.doRequest(ctx, "PATCH", fmt.Sprintf("/v0/corps/%s/sites/%s/alerts", corpName, siteName), string(b))
 	if err != nil {
 		return CustomAlert{}, err
 	}
@@ -594,7 +825,12 @@ func (sc *Client) CreateCustomAlert(corpName, siteName string, body CustomAlertB
 
 // GetCustomAlert gets a custom alert by ID
 func (sc *Client) GetCustomAlert(corpName, siteName, id string) (CustomAlert, error) {
-	resp, err := sc.doRequest("GET", fmt.Sprintf("/v0/corps/%s/sites/%s/alerts/%s", corpName, siteName, id), "")
+	return sc.GetCustomAlertContext(context.Background(), corpName, siteName, id)
+}
+
+// GetCustomAlertContext is like GetCustomAlert but uses the given context.
+func (sc *Client) GetCustomAlertContext(ctx context.Context, corpName, siteName, id string) (CustomAlert, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/sites/%s/alerts/%s", corpName, siteName, id), "")
 	if err != nil {
 		return CustomAlert{}, err
 	}
@@ -610,12 +846,17 @@ func (sc *Client) GetCustomAlert(corpName, siteName, id string) (CustomAlert, er
 
 // UpdateCustomAlert updates a custom alert by id.
 func (sc *Client) UpdateCustomAlert(corpName, siteName, id string, body CustomAlertBody) (CustomAlert, error) {
+	return sc.UpdateCustomAlertContext(context.Background(), corpName, siteName, id, body)
+}
+
+// UpdateCustomAlertContext is like UpdateCustomAlert but uses the given context.
+func (sc *Client) UpdateCustomAlertContext(ctx context.Context, corpName, siteName, id string, body CustomAlertBody) (CustomAlert, error) {
 	b, err := json.Marshal(body)
 	if err != nil {
 		return CustomAlert{}, err
 	}
 
-	resp, err := sc.doRequest("PATCH", fmt.Sprintf("/v0/corps/%s/sites/%s/alerts/%s", corpName, siteName, id), string(b))
+	resp, err := sc.doRequest(ctx, "PATCH", fmt.Sprintf("/v0/corps/%s/sites/%s/alerts/%s", corpName, siteName, id), string(b))
 	if err != nil {
 		return CustomAlert{}, err
 	}
@@ -631,7 +872,12 @@ func (sc *Client) UpdateCustomAlert(corpName, siteName, id string, body CustomAl
 
 // DeleteCustomAlert deletes a custom alert.
 func (sc *Client) DeleteCustomAlert(corpName, siteName, id string) error {
-	_, err := sc.doRequest("DELETE", fmt.Sprintf("/v0/corps/%s/sites/%s/alerts/%s", corpName, siteName, id), "")
+	return sc.DeleteCustomAlertContext(context.Background(), corpName, siteName, id)
+}
+
+// DeleteCustomAlertContext is like DeleteCustomAlert but uses the given context.
+func (sc *Client) DeleteCustomAlertContext(ctx context.Context, corpName, siteName, id string) error {
+	_, err := sc.doRequest(ctx, "DELETE", fmt.Sprintf("/v0/corps/%s/sites/%s/alerts/%s", corpName, siteName, id), "")
 
 	return err
 }
@@ -662,11 +908,16 @@ type eventsResponse struct {
 
 // ListEvents lists events for a given site.
 func (sc *Client) ListEvents(corpName, siteName string, query url.Values) ([]Event, error) {
+	return sc.ListEventsContext(context.Background(), corpName, siteName, query)
+}
+
+// ListEventsContext is like ListEvents but uses the given context.
+func (sc *Client) ListEventsContext(ctx context.Context, corpName, siteName string, query url.Values) ([]Event, error) {
 	url := fmt.Sprintf("/v0/corps/%s/sites/%s/events", corpName, siteName)
 	if query.Encode() != "" {
 		url += "?" + query.Encode()
 	}
-	resp, err := sc.doRequest("GET", url, "")
+	resp, err := sc.doRequest(ctx, "GET", url, "")
 	if err != nil {
 		return []Event{}, err
 	}
@@ -682,7 +933,12 @@ func (sc *Client) ListEvents(corpName, siteName string, query url.Values) ([]Eve
 
 // GetEvent gets an event by ID.
 func (sc *Client) GetEvent(corpName, siteName, id string) (Event, error) {
-	resp, err := sc.doRequest("GET", fmt.Sprintf("/v0/corps/%s/sites/%s/events/%s", corpName, siteName, id), "")
+	return sc.GetEventContext(context.Background(), corpName, siteName, id)
+}
+
+// GetEventContext is like GetEvent but uses the given context.
+func (sc *Client) GetEventContext(ctx context.Context, corpName, siteName, id string) (Event, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/sites/%s/events/%s", corpName, siteName, id), "")
 	if err != nil {
 		return Event{}, err
 	}
@@ -698,7 +954,12 @@ func (sc *Client) GetEvent(corpName, siteName, id string) (Event, error) {
 
 // ExpireEvent expires an event by ID.
 func (sc *Client) ExpireEvent(corpName, siteName, id string) (Event, error) {
-	resp, err := sc.doRequest("POST", fmt.Sprintf("/v0/corps/%s/sites/%s/events/%s/expire", corpName, siteName, id), "")
+	return sc.ExpireEventContext(context.Background(), corpName, siteName, id)
+}
+
+// ExpireEventContext is like ExpireEvent but uses the given context.
+func (sc *Client) ExpireEventContext(ctx context.Context, corpName, siteName, id string) (Event, error) {
+	resp, err := sc.doRequest(ctx, "POST", fmt.Sprintf("/v0/corps/%s/sites/%s/events/%s/expire", corpName, siteName, id), "")
 	if err != nil {
 		return Event{}, err
 	}
@@ -750,11 +1011,16 @@ type requestsResponse struct {
 
 // SearchRequests searches requests.
 func (sc *Client) SearchRequests(corpName, siteName string, query url.Values) (next string, requests []Request, err error) {
+	return sc.SearchRequestsContext(context.Background(), corpName, siteName, query)
+}
+
+// SearchRequestsContext is like SearchRequests but uses the given context.
+func (sc *Client) SearchRequestsContext(ctx context.Context, corpName, siteName string, query url.Values) (next string, requests []Request, err error) {
 	url := fmt.Sprintf("/v0/corps/%s/sites/%s/requests", corpName, siteName)
 	if query.Encode() != "" {
 		url += "?" + query.Encode()
 	}
-	resp, err := sc.doRequest("GET", url, "")
+	resp, err := sc.doRequest(ctx, "GET", url, "")
 	if err != nil {
 		return "", []Request{}, err
 	}
@@ -770,7 +1036,12 @@ func (sc *Client) SearchRequests(corpName, siteName string, query url.Values) (n
 
 // GetRequest gets a request by id.
 func (sc *Client) GetRequest(corpName, siteName, id string) (Request, error) {
-	resp, err := sc.doRequest("GET", fmt.Sprintf("/v0/corps/%s/sites/%s/requests/%s", corpName, siteName, id), "")
+	return sc.GetRequestContext(context.Background(), corpName, siteName, id)
+}
+
+// GetRequestContext is like GetRequest but uses the given context.
+func (sc *Client) GetRequestContext(ctx context.Context, corpName, siteName, id string) (Request, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/sites/%s/requests/%s", corpName, siteName, id), "")
 	if err != nil {
 		return Request{}, err
 	}
@@ -792,11 +1063,16 @@ type requestFeedResponse struct {
 
 // GetRequestFeed gets the request feed for the site.
 func (sc *Client) GetRequestFeed(corpName, siteName string, query url.Values) (next string, requests []Request, err error) {
+	return sc.GetRequestFeedContext(context.Background(), corpName, siteName, query)
+}
+
+// GetRequestFeedContext is like GetRequestFeed but uses the given context.
+func (sc *Client) GetRequestFeedContext(ctx context.Context, corpName, siteName string, query url.Values) (next string, requests []Request, err error) {
 	url := fmt.Sprintf("/v0/corps/%s/sites/%s/feed/requests", corpName, siteName)
 	if query.Encode() != "" {
 		url += "?" + query.Encode()
 	}
-	resp, err := sc.doRequest("GET", url, "")
+	resp, err := sc.doRequest(ctx, "GET", url, "")
 	if err != nil {
 		return "", []Request{}, err
 	}
@@ -827,7 +1103,12 @@ type whitelistResponse struct {
 
 // ListWhitelistIPs lists whitelisted IP addresses.
 func (sc *Client) ListWhitelistIPs(corpName, siteName string) ([]ListIP, error) {
-	resp, err := sc.doRequest("GET", fmt.Sprintf("/v0/corps/%s/sites/%s/whitelist", corpName, siteName), "")
+	return sc.ListWhitelistIPsContext(context.Background(), corpName, siteName)
+}
+
+// ListWhitelistIPsContext is like ListWhitelistIPs but uses the given context.
+func (sc *Client) ListWhitelistIPsContext(ctx context.Context, corpName, siteName string) ([]ListIP, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/sites/%s/whitelist", corpName, siteName), "")
 	if err != nil {
 		return []ListIP{}, err
 	}
@@ -869,12 +1150,17 @@ func (b ListIPBody) MarshalJSON() ([]byte, error) {
 
 // AddWhitelistIP adds an IP address to the whitelist.
 func (sc *Client) AddWhitelistIP(corpName, siteName string, body ListIPBody) (ListIP, error) {
+	return sc.AddWhitelistIPContext(context.Background(), corpName, siteName, body)
+}
+
+// AddWhitelistIPContext is like AddWhitelistIP but uses the given context.
+func (sc *Client) AddWhitelistIPContext(ctx context.Context, corpName, siteName string, body ListIPBody) (ListIP, error) {
 	b, err := json.Marshal(body)
 	if err != nil {
 		return ListIP{}, err
 	}
 
-	resp, err := sc.doRequest("POST", fmt.Sprintf("/v0/corps/%s/sites/%s/whitelist", corpName, siteName), string(b))
+	resp, err := sc.doRequest(ctx, "POST", fmt.Sprintf("/v0/corps/%s/sites/%s/whitelist", corpName, siteName), string(b))
 	if err != nil {
 		return ListIP{}, err
 	}
@@ -890,7 +1176,12 @@ func (sc *Client) AddWhitelistIP(corpName, siteName string, body ListIPBody) (Li
 
 // DeleteWhitelistIP deletes a whitelisted IP by id.
 func (sc *Client) DeleteWhitelistIP(corpName, siteName, id string) error {
-	_, err := sc.doRequest("DELETE", fmt.Sprintf("/v0/corps/%s/sites/%s/whitelist/%s", corpName, siteName, id), "")
+	return sc.DeleteWhitelistIPContext(context.Background(), corpName, siteName, id)
+}
+
+// DeleteWhitelistIPContext is like DeleteWhitelistIP but uses the given context.
+func (sc *Client) DeleteWhitelistIPContext(ctx context.Context, corpName, siteName, id string) error {
+	_, err := sc.doRequest(ctx, "DELETE", fmt.Sprintf("/v0/corps/%s/sites/%s/whitelist/%s", corpName, siteName, id), "")
 
 	return err
 }
@@ -902,7 +1193,12 @@ type blacklistResponse struct {
 
 // ListBlacklistIPs lists blacklisted IP addresses.
 func (sc *Client) ListBlacklistIPs(corpName, siteName string) ([]ListIP, error) {
-	resp, err := sc.doRequest("GET", fmt.Sprintf("/v0/corps/%s/sites/%s/blacklist", corpName, siteName), "")
+	return sc.ListBlacklistIPsContext(context.Background(), corpName, siteName)
+}
+
+// ListBlacklistIPsContext is like ListBlacklistIPs but uses the given context.
+func (sc *Client) ListBlacklistIPsContext(ctx context.Context, corpName, siteName string) ([]ListIP, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/sites/%s/blacklist", corpName, siteName), "")
 	if err != nil {
 		return []ListIP{}, err
 	}
@@ -918,12 +1214,17 @@ func (sc *Client) ListBlacklistIPs(corpName, siteName string) ([]ListIP, error)
 
 // AddBlacklistIP adds an IP address to the blacklist.
 func (sc *Client) AddBlacklistIP(corpName, siteName string, body ListIPBody) (ListIP, error) {
+	return sc.AddBlacklistIPContext(context.Background(), corpName, siteName, body)
+}
+
+// AddBlacklistIPContext is like AddBlacklistIP but uses the given context.
+func (sc *Client) AddBlacklistIPContext(ctx context.Context, corpName, siteName string, body ListIPBody) (ListIP, error) {
 	b, err := json.Marshal(body)
 	if err != nil {
 		return ListIP{}, err
 	}
 
-	resp, err := sc.doRequest("POST", fmt.Sprintf("/v0/corps/%s/sites/%s/blacklist", corpName, siteName), string(b))
+	resp, err := sc.doRequest(ctx, "POST", fmt.Sprintf("/v0/corps/%s/sites/%s/blacklist", corpName, siteName), string(b))
 	if err != nil {
 		return ListIP{}, err
 	}
@@ -939,7 +1240,12 @@ func (sc *Client) AddBlacklistIP(corpName, siteName string, body ListIPBody) (Li
 
 // DeleteBlacklistIP deletes a blacklisted IP by id.
 func (sc *Client) DeleteBlacklistIP(corpName, siteName, id string) error {
-	_, err := sc.doRequest("DELETE", fmt.Sprintf("/v0/corps/%s/sites/%s/blacklist/%s", corpName, siteName, id), "")
+	return sc.DeleteBlacklistIPContext(context.Background(), corpName, siteName, id)
+}
+
+// DeleteBlacklistIPContext is like DeleteBlacklistIP but uses the given context.
+func (sc *Client) DeleteBlacklistIPContext(ctx context.Context, corpName, siteName, id string) error {
+	_, err := sc.doRequest(ctx, "DELETE", fmt.Sprintf("/v0/corps/%s/sites/%s/blacklist/%s", corpName, siteName, id), "")
 
 	return err
 }
@@ -960,7 +1266,12 @@ type redactionsResponse struct {
 
 // ListRedactions lists redactions.
 func (sc *Client) ListRedactions(corpName, siteName string) ([]Redaction, error) {
-	resp, err := sc.doRequest("GET", fmt.Sprintf("/v0/corps/%s/sites/%s/redactions", corpName, siteName), "")
+	return sc.ListRedactionsContext(context.Background(), corpName, siteName)
+}
+
+// ListRedactionsContext is like ListRedactions but uses the given context.
+func (sc *Client) ListRedactionsContext(ctx context.Context, corpName, siteName string) ([]Redaction, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/sites/%s/redactions", corpName, siteName), "")
 	if err != nil {
 		return []Redaction{}, err
 	}
@@ -983,12 +1294,17 @@ type RedactionBody struct {
 
 // AddRedaction adds a redaction.
 func (sc *Client) AddRedaction(corpName, siteName string, body RedactionBody) ([]Redaction, error) {
+	return sc.AddRedactionContext(context.Background(), corpName, siteName, body)
+}
+
+// AddRedactionContext is like AddRedaction but uses the given context.
+func (sc *Client) AddRedactionContext(ctx context.Context, corpName, siteName string, body RedactionBody) ([]Redaction, error) {
 	b, err := json.Marshal(body)
 	if err != nil {
 		return []Redaction{}, err
 	}
 
-	resp, err := sc.doRequest("POST", fmt.Sprintf("/v0/corps/%s/sites/%s/redactions", corpName, siteName), string(b))
+	resp, err := sc.doRequest(ctx, "POST", fmt.Sprintf("/v0/corps/%s/sites/%s/redactions", corpName, siteName), string(b))
 	if err != nil {
 		return []Redaction{}, err
 	}
@@ -1002,20 +1318,26 @@ func (sc *Client) AddRedaction(corpName, siteName string, body RedactionBody) ([
 	return r.Data, nil
 }
 
-// UpdateRedactionBody is the body for updating an integration.
+// UpdateRedactionBody is the body for updating a redaction.
+// Nil fields are left unchanged; use String and Int to set them.
 type UpdateRedactionBody struct {
-	Field         string `json:"field,omitempty"`
-	RedactionType int    `json:"redactionType,omitempty"`
+	Field         *string `json:"field,omitempty"`
+	RedactionType *int    `json:"redactionType,omitempty"`
 }
 
 // UpdateRedaction updates a redaction by id.
 func (sc *Client) UpdateRedaction(corpName, siteName, id string, body UpdateRedactionBody) (Redaction, error) {
+	return sc.UpdateRedactionContext(context.Background(), corpName, siteName, id, body)
+}
+
+// UpdateRedactionContext is like UpdateRedaction but uses the given context.
+func (sc *Client) UpdateRedactionContext(ctx context.Context, corpName, siteName, id string, body UpdateRedactionBody) (Redaction, error) {
 	b, err := json.Marshal(body)
 	if err != nil {
 		return Redaction{}, err
 	}
 
-	resp, err := sc.doRequest("PATCH", fmt.Sprintf("/v0/corps/%s/sites/%s/redactions/%s", corpName, siteName, id), string(b))
+	resp, err := sc.doRequest(ctx, "PATCH", fmt.Sprintf("/v0/corps/%s/sites/%s/redactions/%s", corpName, siteName, id), string(b))
 	if err != nil {
 		return Redaction{}, err
 	}
@@ -1031,7 +1353,12 @@ func (sc *Client) UpdateRedaction(corpName, siteName, id string, body UpdateReda
 
 // GetRedaction gets a redaction by id.
 func (sc *Client) GetRedaction(corpName, siteName, id string) (Redaction, error) {
-	resp, err := sc.doRequest("GET", fmt.Sprintf("/v0/corps/%s/sites/%s/redactions/%s", corpName, siteName, id), "")
+	return sc.GetRedactionContext(context.Background(), corpName, siteName, id)
+}
+
+// GetRedactionContext is like GetRedaction but uses the given context.
+func (sc *Client) GetRedactionContext(ctx context.Context, corpName, siteName, id string) (Redaction, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/sites/%s/redactions/%s", corpName, siteName, id), "")
 	if err != nil {
 		return Redaction{}, err
 	}
@@ -1047,7 +1374,12 @@ func (sc *Client) GetRedaction(corpName, siteName, id string) (Redaction, error)
 
 // DeleteRedaction deletes a redaction by id.
 func (sc *Client) DeleteRedaction(corpName, siteName, id string) error {
-	_, err := sc.doRequest("DELETE", fmt.Sprintf("/v0/corps/%s/sites/%s/redactions/%s", corpName, siteName, id), "")
+	return sc.DeleteRedactionContext(context.Background(), corpName, siteName, id)
+}
+
+// DeleteRedactionContext is like DeleteRedaction but uses the given context.
+func (sc *Client) DeleteRedactionContext(ctx context.Context, corpName, siteName, id string) error {
+	_, err := sc.doRequest(ctx, "DELETE", fmt.Sprintf("/v0/corps/%s/sites/%s/redactions/%s", corpName, siteName, id), "")
 
 	return err
 }
@@ -1056,9 +1388,9 @@ func (sc *Client) DeleteRedaction(corpName, siteName, id string) error {
 type Integration struct {
 	ID        string
 	Name      string
-	Type      string
+	Type      IntegrationType
 	URL       string
-	Events    []string
+	Events    []IntegrationEvent
 	Active    bool
 	Note      string
 	CreatedBy string
@@ -1072,7 +1404,12 @@ type integrationsResponse struct {
 
 // ListIntegrations lists integrations.
 func (sc *Client) ListIntegrations(corpName, siteName string) ([]Integration, error) {
-	resp, err := sc.doRequest("GET", fmt.Sprintf("/v0/corps/%s/sites/%s/integrations", corpName, siteName), "")
+	return sc.ListIntegrationsContext(context.Background(), corpName, siteName)
+}
+
+// ListIntegrationsContext is like ListIntegrations but uses the given context.
+func (sc *Client) ListIntegrationsContext(ctx context.Context, corpName, siteName string) ([]Integration, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/sites/%s/integrations", corpName, siteName), "")
 	if err != nil {
 		return []Integration{}, err
 	}
@@ -1088,19 +1425,26 @@ func (sc *Client) ListIntegrations(corpName, siteName string) ([]Integration, er
 
 // IntegrationBody is the body for adding an integration.
 type IntegrationBody struct {
-	URL    string   `json:"url"`
-	Type   string   `json:"type"`
-	Events []string `json:"events"`
+	URL    string             `json:"url"`
+	Type   IntegrationType    `json:"type"`
+	Events []IntegrationEvent `json:"events"`
 }
 
-// AddIntegration adds an integration.
+// AddIntegration adds an integration. The body is not validated, so that
+// types and events added to the API can be used; call Validate to check
+// it first.
 func (sc *Client) AddIntegration(corpName, siteName string, body IntegrationBody) ([]Integration, error) {
+	return sc.AddIntegrationContext(context.Background(), corpName, siteName, body)
+}
+
+// AddIntegrationContext is like AddIntegration but uses the given context.
+func (sc *Client) AddIntegrationContext(ctx context.Context, corpName, siteName string, body IntegrationBody) ([]Integration, error) {
 	b, err := json.Marshal(body)
 	if err != nil {
 		return []Integration{}, err
 	}
 
-	resp, err := sc.doRequest("POST", fmt.Sprintf("/v0/corps/%s/sites/%s/integrations", corpName, siteName), string(b))
+	resp, err := sc.doRequest(ctx, "POST", fmt.Sprintf("/v0/corps/%s/sites/%s/integrations", corpName, siteName), string(b))
 	if err != nil {
 		return []Integration{}, err
 	}
@@ -1116,7 +1460,12 @@ func (sc *Client) AddIntegration(corpName, siteName string, body IntegrationBody
 
 // GetIntegration gets an integration by id.
 func (sc *Client) GetIntegration(corpName, siteName, id string) (Integration, error) {
-	resp, err := sc.doRequest("GET", fmt.Sprintf("/v0/corps/%s/sites/%s/integrations/%s", corpName, siteName, id), "")
+	return sc.GetIntegrationContext(context.Background(), corpName, siteName, id)
+}
+
+// GetIntegrationContext is like GetIntegration but uses the given context.
+func (sc *Client) GetIntegrationContext(ctx context.Context, corpName, siteName, id string) (Integration, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/sites/%s/integrations/%s", corpName, siteName, id), "")
 	if err != nil {
 		return Integration{}, err
 	}
@@ -1132,24 +1481,35 @@ func (sc *Client) GetIntegration(corpName, siteName, id string) (Integration, er
 
 // UpdateIntegrationBody is the body for updating an integration.
 type UpdateIntegrationBody struct {
-	URL    string   `json:"url,omitempty"`
-	Events []string `json:"smallIconURI,omitempty"`
+	URL    string             `json:"url,omitempty"`
+	Events []IntegrationEvent `json:"events,omitempty"`
 }
 
-// UpdateIntegration updates an integration by id.
+// UpdateIntegration updates an integration by id. The events are not
+// validated; see AddIntegration.
 func (sc *Client) UpdateIntegration(corpName, siteName, id string, body UpdateIntegrationBody) error {
+	return sc.UpdateIntegrationContext(context.Background(), corpName, siteName, id, body)
+}
+
+// UpdateIntegrationContext is like UpdateIntegration but uses the given context.
+func (sc *Client) UpdateIntegrationContext(ctx context.Context, corpName, siteName, id string, body UpdateIntegrationBody) error {
 	b, err := json.Marshal(body)
 	if err != nil {
 		return err
 	}
 
-	_, err = sc.doRequest("PATCH", fmt.Sprintf("/v0/corps/%s/sites/%s/integrations/%s", corpName, siteName, id), string(b))
+	_, err = sc.doRequest(ctx, "PATCH", fmt.Sprintf("/v0/corps/%s/sites/%s/integrations/%s", corpName, siteName, id), string(b))
 	return err
 }
 
-// DeleteIntegration deletes a redaction by id.
+// DeleteIntegration deletes an integration by id.
 func (sc *Client) DeleteIntegration(corpName, siteName, id string) error {
-	_, err := sc.doRequest("DELETE", fmt.Sprintf("/v0/corps/%s/sites/%s/integrations/%s", corpName, siteName, id), "")
+	return sc.DeleteIntegrationContext(context.Background(), corpName, siteName, id)
+}
+
+// DeleteIntegrationContext is like DeleteIntegration but uses the given context.
+func (sc *Client) DeleteIntegrationContext(ctx context.Context, corpName, siteName, id string) error {
+	_, err := sc.doRequest(ctx, "DELETE", fmt.Sprintf("/v0/corps/%s/sites/%s/integrations/%s", corpName, siteName, id), "")
 
 	return err
 }
@@ -1171,7 +1531,12 @@ type paramsResponse struct {
 
 // ListParams lists whitelisted parameters.
 func (sc *Client) ListParams(corpName, siteName string) ([]Param, error) {
-	resp, err := sc.doRequest("GET", fmt.Sprintf("/v0/corps/%s/sites/%s/paramwhitelist", corpName, siteName), "")
+	return sc.ListParamsContext(context.Background(), corpName, siteName)
+}
+
+// ListParamsContext is like ListParams but uses the given context.
+func (sc *Client) ListParamsContext(ctx context.Context, corpName, siteName string) ([]Param, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/sites/%s/paramwhitelist", corpName, siteName), "")
 	if err != nil {
 		return []Param{}, err
 	}
@@ -1185,6 +1550,72 @@ func (sc *Client) ListParams(corpName, siteName string) ([]Param, error) {
 	return pr.Data, nil
 }
 
+// ParamBody is the body for adding a whitelisted parameter.
+type ParamBody struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+	Note string `json:"note"`
+}
+
+// AddParam adds a whitelisted parameter.
+func (sc *Client) AddParam(corpName, siteName string, body ParamBody) (Param, error) {
+	return sc.AddParamContext(context.Background(), corpName, siteName, body)
+}
+
+// AddParamContext is like AddParam but uses the given context.
+func (sc *Client) AddParamContext(ctx context.Context, corpName, siteName string, body ParamBody) (Param, error) {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return Param{}, err
+	}
+
+	resp, err := sc.doRequest(ctx, "POST", fmt.Sprintf("/v0/corps/%s/sites/%s/paramwhitelist", corpName, siteName), string(b))
+	if err != nil {
+		return Param{}, err
+	}
+
+	var p Param
+	err = json.Unmarshal(resp, &p)
+	if err != nil {
+		return Param{}, err
+	}
+
+	return p, nil
+}
+
+// GetParam gets a whitelisted parameter by id.
+func (sc *Client) GetParam(corpName, siteName, id string) (Param, error) {
+	return sc.GetParamContext(context.Background(), corpName, siteName, id)
+}
+
+// GetParamContext is like GetParam but uses the given context.
+func (sc *Client) GetParamContext(ctx context.Context, corpName, siteName, id string) (Param, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/sites/%s/paramwhitelist/%s", corpName, siteName, id), "")
+	if err != nil {
+		return Param{}, err
+	}
+
+	var p Param
+	err = json.Unmarshal(resp, &p)
+	if err != nil {
+		return Param{}, err
+	}
+
+	return p, nil
+}
+
+// DeleteParam deletes a whitelisted parameter by id.
+func (sc *Client) DeleteParam(corpName, siteName, id string) error {
+	return sc.DeleteParamContext(context.Background(), corpName, siteName, id)
+}
+
+// DeleteParamContext is like DeleteParam but uses the given context.
+func (sc *Client) DeleteParamContext(ctx context.Context, corpName, siteName, id string) error {
+	_, err := sc.doRequest(ctx, "DELETE", fmt.Sprintf("/v0/corps/%s/sites/%s/paramwhitelist/%s", corpName, siteName, id), "")
+
+	return err
+}
+
 // Path is a whitelisted path.
 type Path struct {
 	ID        string
@@ -1201,7 +1632,12 @@ type pathsResponse struct {
 
 // ListPaths lists whitelisted paths.
 func (sc *Client) ListPaths(corpName, siteName string) ([]Path, error) {
-	resp, err := sc.doRequest("GET", fmt.Sprintf("/v0/corps/%s/sites/%s/pathwhitelist", corpName, siteName), "")
+	return sc.ListPathsContext(context.Background(), corpName, siteName)
+}
+
+// ListPathsContext is like ListPaths but uses the given context.
+func (sc *Client) ListPathsContext(ctx context.Context, corpName, siteName string) ([]Path, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/sites/%s/pathwhitelist", corpName, siteName), "")
 	if err != nil {
 		return []Path{}, err
 	}
@@ -1215,9 +1651,79 @@ func (sc *Client) ListPaths(corpName, siteName string) ([]Path, error) {
 	return pr.Data, nil
 }
 
+// PathBody is the body for adding a whitelisted path.
+type PathBody struct {
+	Path string `json:"path"`
+	Note string `json:"note"`
+}
+
+// AddPath adds a whitelisted path.
+func (sc *Client) AddPath(corpName, siteName string, body PathBody) (Path, error) {
+	return sc.AddPathContext(context.Background(), corpName, siteName, body)
+}
+
+// AddPathContext is like AddPath but uses the given context.
+func (sc *Client) AddPathContext(ctx context.Context, corpName, siteName string, body PathBody) (Path, error) {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return Path{}, err
+	}
+
+	resp, err := sc.doRequest(ctx, "POST", fmt.Sprintf("/v0/corps/%s/sites/%s/pathwhitelist", corpName, siteName), string(b))
+	if err != nil {
+		return Path{}, err
+	}
+
+	var p Path
+	err = json.Unmarshal(resp, &p)
+	if err != nil {
+		return Path{}, err
+	}
+
+	return p, nil
+}
+
+// GetPath gets a whitelisted path by id.
+func (sc *Client) GetPath(corpName, siteName, id string) (Path, error) {
+	return sc.GetPathContext(context.Background(), corpName, siteName, id)
+}
+
+// GetPathContext is like GetPath but uses the given context.
+func (sc *Client) GetPathContext(ctx context.Context, corpName, siteName, id string) (Path, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/sites/%s/pathwhitelist/%s", corpName, siteName, id), "")
+	if err != nil {
+		return Path{}, err
+	}
+
+	var p Path
+	err = json.Unmarshal(resp, &p)
+	if err != nil {
+		return Path{}, err
+	}
+
+	return p, nil
+}
+
+// DeletePath deletes a whitelisted path by id.
+func (sc *Client) DeletePath(corpName, siteName, id string) error {
+	return sc.DeletePathContext(context.Background(), corpName, siteName, id)
+}
+
+// DeletePathContext is like DeletePath but uses the given context.
+func (sc *Client) DeletePathContext(ctx context.Context, corpName, siteName, id string) error {
+	_, err := sc.doRequest(ctx, "DELETE", fmt.Sprintf("/v0/corps/%s/sites/%s/pathwhitelist/%s", corpName, siteName, id), "")
+
+	return err
+}
+
 // ListSiteActivity lists activity events for a given site.
 func (sc *Client) ListSiteActivity(corpName, siteName string, limit, page int) ([]ActivityEvent, error) {
-	resp, err := sc.doRequest("GET", fmt.Sprintf("/v0/corps/%s/sites/%s/activity?limit=%d&page=%d", corpName, siteName, limit, page), "")
+	return sc.ListSiteActivityContext(context.Background(), corpName, siteName, limit, page)
+}
+
+// ListSiteActivityContext is like ListSiteActivity but uses the given context.
+func (sc *Client) ListSiteActivityContext(ctx context.Context, corpName, siteName string, limit, page int) ([]ActivityEvent, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/sites/%s/activity?limit=%d&page=%d", corpName, siteName, limit, page), "")
 	if err != nil {
 		return []ActivityEvent{}, err
 	}
@@ -1231,10 +1737,22 @@ func (sc *Client) ListSiteActivity(corpName, siteName string, limit, page int) (
 	return ar.Data, nil
 }
 
+// HeaderLinkType is the type of header a header link applies to.
+type HeaderLinkType string
+
+// All available HeaderLinkTypes
+const (
+	HeaderLinkTypeRequest  = HeaderLinkType("request")
+	HeaderLinkTypeResponse = HeaderLinkType("response")
+)
+
+// headerLinkPlaceholder is replaced by the header value in header links.
+const headerLinkPlaceholder = "{{value}}"
+
 // HeaderLink contains the data for a response or request header link
 type HeaderLink struct {
 	ID        string
-	Type      string
+	Type      HeaderLinkType
 	Name      string
 	LinkName  string
 	Link      string
@@ -1249,7 +1767,12 @@ type headerLinksResponse struct {
 
 // ListHeaderLinks lists header links.
 func (sc *Client) ListHeaderLinks(corpName, siteName string) ([]HeaderLink, error) {
-	resp, err := sc.doRequest("GET", fmt.Sprintf("/v0/corps/%s/sites/%s/headerLinks", corpName, siteName), "")
+	return sc.ListHeaderLinksContext(context.Background(), corpName, siteName)
+}
+
+// ListHeaderLinksContext is like ListHeaderLinks but uses the given context.
+func (sc *Client) ListHeaderLinksContext(ctx context.Context, corpName, siteName string) ([]HeaderLink, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/sites/%s/headerLinks", corpName, siteName), "")
 	if err != nil {
 		return []HeaderLink{}, err
 	}
@@ -1263,22 +1786,52 @@ func (sc *Client) ListHeaderLinks(corpName, siteName string) ([]HeaderLink, erro
 	return hr.Data, nil
 }
 
-// HeaderLinkBody is the body for creating a header link.
+// HeaderLinkBody is the body for creating or updating a header link.
+// Link must contain the {{value}} placeholder, which the dashboard
+// replaces with the value of the header.
 type HeaderLinkBody struct {
-	Type     string `json:"type"`
-	Name     string `json:"name"`
-	LinkName string `json:"linkName"`
-	Link     string `json:"link"`
+	Type     HeaderLinkType `json:"type"`
+	Name     string         `json:"name"`
+	LinkName string         `json:"linkName"`
+	Link     string         `json:"link"`
+}
+
+// Validate checks the header link body for errors the API would reject.
+func (b HeaderLinkBody) Validate() error {
+	switch b.Type {
+	case HeaderLinkTypeRequest, HeaderLinkTypeResponse:
+	default:
+		return fmt.Errorf("invalid header link type %q: must be %q or %q", b.Type, HeaderLinkTypeRequest, HeaderLinkTypeResponse)
+	}
+
+	if b.Name == "" {
+		return errors.New("header link requires a header name")
+	}
+
+	if !strings.Contains(b.Link, headerLinkPlaceholder) {
+		return fmt.Errorf("header link %q must contain the %s placeholder", b.Link, headerLinkPlaceholder)
+	}
+
+	return nil
 }
 
 // AddHeaderLink adds a header link.
 func (sc *Client) AddHeaderLink(corpName, siteName string, body HeaderLinkBody) ([]HeaderLink, error) {
+	return sc.AddHeaderLinkContext(context.Background(), corpName, siteName, body)
+}
+
+// AddHeaderLinkContext is like AddHeaderLink but uses the given context.
+func (sc *Client) AddHeaderLinkContext(ctx context.Context, corpName, siteName string, body HeaderLinkBody) ([]HeaderLink, error) {
+	if err := body.Validate(); err != nil {
+		return []HeaderLink{}, err
+	}
+
 	b, err := json.Marshal(body)
 	if err != nil {
 		return []HeaderLink{}, err
 	}
 
-	resp, err := sc.doRequest("POST", fmt.Sprintf("/v0/corps/%s/sites/%s/pathwhitelist", corpName, siteName), string(b))
+	resp, err := sc.doRequest(ctx, "POST", fmt.Sprintf("/v0/corps/%s/sites/%s/headerLinks", corpName, siteName), string(b))
 	if err != nil {
 		return []HeaderLink{}, err
 	}
@@ -1294,7 +1847,42 @@ func (sc *Client) AddHeaderLink(corpName, siteName string, body HeaderLinkBody)
 
 // GetHeaderLink gets a header link by id.
 func (sc *Client) GetHeaderLink(corpName, siteName, id string) (HeaderLink, error) {
-	resp, err := sc.doRequest("GET", fmt.Sprintf("/v0/corps/%s/sites/%s/headerLinks/%s", corpName, siteName, id), "")
+	return sc.GetHeaderLinkContext(context.Background(), corpName, siteName, id)
+}
+
+// GetHeaderLinkContext is like GetHeaderLink but uses the given context.
+func (sc *Client) GetHeaderLinkContext(ctx context.Context, corpName, siteName, id string) (HeaderLink, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/sites/%s/headerLinks/%s", corpName, siteName, id), "")
+	if err != nil {
+		return HeaderLink{}, err
+	}
+
+	var h HeaderLink
+	err = json.Unmarshal(resp, &h)
+	if err != nil {
+		return HeaderLink{}, err
+	}
+
+	return h, nil
+}
+
+// UpdateHeaderLink updates a header link by id.
+func (sc *Client) UpdateHeaderLink(corpName, siteName, id string, body HeaderLinkBody) (HeaderLink, error) {
+	return sc.UpdateHeaderLinkContext(context.Background(), corpName, siteName, id, body)
+}
+
+// UpdateHeaderLinkContext is like UpdateHeaderLink but uses the given context.
+func (sc *Client) UpdateHeaderLinkContext(ctx context.Context, corpName, siteName, id string, body HeaderLinkBody) (HeaderLink, error) {
+	if err := body.Validate(); err != nil {
+		return HeaderLink{}, err
+	}
+
+	b, err := json.Marshal(body)
+	if err != nil {
+		return HeaderLink{}, err
+	}
+
+	resp, err := sc.doRequest(ctx, "PATCH", fmt.Sprintf("/v0/corps/%s/sites/%s/headerLinks/%s", corpName, siteName, id), string(b))
 	if err != nil {
 		return HeaderLink{}, err
 	}
@@ -1310,7 +1898,12 @@ func (sc *Client) GetHeaderLink(corpName, siteName, id string) (HeaderLink, erro
 
 // DeleteHeaderLink deletes a header link by id.
 func (sc *Client) DeleteHeaderLink(corpName, siteName, id string) error {
-	_, err := sc.doRequest("DELETE", fmt.Sprintf("/v0/corps/%s/sites/%s/headerLinks/%s", corpName, siteName, id), "")
+	return sc.DeleteHeaderLinkContext(context.Background(), corpName, siteName, id)
+}
+
+// DeleteHeaderLinkContext is like DeleteHeaderLink but uses the given context.
+func (sc *Client) DeleteHeaderLinkContext(ctx context.Context, corpName, siteName, id string) error {
+	_, err := sc.doRequest(ctx, "DELETE", fmt.Sprintf("/v0/corps/%s/sites/%s/headerLinks/%s", corpName, siteName, id), "")
 
 	return err
 }
@@ -1335,7 +1928,12 @@ type siteMembersResponse struct {
 
 // ListSiteMembers lists site members.
 func (sc *Client) ListSiteMembers(corpName, siteName string) ([]SiteMember, error) {
-	resp, err := sc.doRequest("GET", fmt.Sprintf("/v0/corps/%s/sites/%s/members", corpName, siteName), "")
+	return sc.ListSiteMembersContext(context.Background(), corpName, siteName)
+}
+
+// ListSiteMembersContext is like ListSiteMembers but uses the given context.
+func (sc *Client) ListSiteMembersContext(ctx context.Context, corpName, siteName string) ([]SiteMember, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/sites/%s/members", corpName, siteName), "")
 	if err != nil {
 		return []SiteMember{}, err
 	}
@@ -1349,19 +1947,24 @@ func (sc *Client) ListSiteMembers(corpName, siteName string) ([]SiteMember, erro
 	return sr.Data, nil
 }
 
-// siteMembersBody is the body for adding one or more existing users to a site.
-type siteMembersBody struct {
+// SiteMembersBody is the body for adding one or more existing users to a site.
+type SiteMembersBody struct {
 	Members []string `json:"members"`
 }
 
 // AddSiteMembers adds one or more existing users to a site.
-func (sc *Client) AddSiteMembers(corpName, siteName string, body siteMembersBody) ([]SiteMember, error) {
+func (sc *Client) AddSiteMembers(corpName, siteName string, body SiteMembersBody) ([]SiteMember, error) {
+	return sc.AddSiteMembersContext(context.Background(), corpName, siteName, body)
+}
+
+// AddSiteMembersContext is like AddSiteMembers but uses the given context.
+func (sc *Client) AddSiteMembersContext(ctx context.Context, corpName, siteName string, body SiteMembersBody) ([]SiteMember, error) {
 	b, err := json.Marshal(body)
 	if err != nil {
 		return []SiteMember{}, err
 	}
 
-	resp, err := sc.doRequest("POST", fmt.Sprintf("/v0/corps/%s/sites/%s/members", corpName, siteName), string(b))
+	resp, err := sc.doRequest(ctx, "POST", fmt.Sprintf("/v0/corps/%s/sites/%s/members", corpName, siteName), string(b))
 	if err != nil {
 		return []SiteMember{}, err
 	}
@@ -1377,7 +1980,12 @@ func (sc *Client) AddSiteMembers(corpName, siteName string, body siteMembersBody
 
 // GetSiteMember gets a site member by email.
 func (sc *Client) GetSiteMember(corpName, siteName, email string) (SiteMember, error) {
-	resp, err := sc.doRequest("GET", fmt.Sprintf("/v0/corps/%s/sites/%s/members/%s", corpName, siteName, email), "")
+	return sc.GetSiteMemberContext(context.Background(), corpName, siteName, email)
+}
+
+// GetSiteMemberContext is like GetSiteMember but uses the given context.
+func (sc *Client) GetSiteMemberContext(ctx context.Context, corpName, siteName, email string) (SiteMember, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/sites/%s/members/%s", corpName, siteName, email), "")
 	if err != nil {
 		return SiteMember{}, err
 	}
@@ -1405,7 +2013,38 @@ type SiteMemberResponse struct {
 
 // AddSiteMember adds an existing user to a site by email.
 func (sc *Client) AddSiteMember(corpName, siteName, email string) (SiteMemberResponse, error) {
-	resp, err := sc.doRequest("PATCH", fmt.Sprintf("/v0/corps/%s/sites/%s/members/%s", corpName, siteName, email), "")
+	return sc.AddSiteMemberContext(context.Background(), corpName, siteName, email)
+}
+
+// AddSiteMemberContext is like AddSiteMember but uses the given context.
+func (sc *Client) AddSiteMemberContext(ctx context.Context, corpName, siteName, email string) (SiteMemberResponse, error) {
+	resp, err := sc.doRequest(ctx, "PATCH", fmt.Sprintf("/v0/corps/%s/sites/%s/members/%s", corpName, siteName, email), "")
+	if err != nil {
+		return SiteMemberResponse{}, err
+	}
+
+	var sm SiteMemberResponse
+	err = json.Unmarshal(resp, &sm)
+	if err != nil {
+		return SiteMemberResponse{}, err
+	}
+
+	return sm, nil
+}
+
+// SetSiteMemberRole changes the role of a site member by email.
+func (sc *Client) SetSiteMemberRole(corpName, siteName, email string, role Role) (SiteMemberResponse, error) {
+	return sc.SetSiteMemberRoleContext(context.Background(), corpName, siteName, email, role)
+}
+
+// SetSiteMemberRoleContext is like SetSiteMemberRole but uses the given context.
+func (sc *Client) SetSiteMemberRoleContext(ctx context.Context, corpName, siteName, email string, role Role) (SiteMemberResponse, error) {
+	b, err := json.Marshal(SiteMemberBody{Role: role})
+	if err != nil {
+		return SiteMemberResponse{}, err
+	}
+
+	resp, err := sc.doRequest(ctx, "PATCH", fmt.Sprintf("/v0/corps/%s/sites/%s/members/%s", corpName, siteName, email), string(b))
 	if err != nil {
 		return SiteMemberResponse{}, err
 	}
@@ -1421,19 +2060,29 @@ func (sc *Client) AddSiteMember(corpName, siteName, email string) (SiteMemberRes
 
 // DeleteSiteMember deletes a site member by email.
 func (sc *Client) DeleteSiteMember(corpName, siteName, email string) error {
-	_, err := sc.doRequest("DELETE", fmt.Sprintf("/v0/corps/%s/sites/%s/members/%s", corpName, siteName, email), "")
+	return sc.DeleteSiteMemberContext(context.Background(), corpName, siteName, email)
+}
+
+// DeleteSiteMemberContext is like DeleteSiteMember but uses the given context.
+func (sc *Client) DeleteSiteMemberContext(ctx context.Context, corpName, siteName, email string) error {
+	_, err := sc.doRequest(ctx, "DELETE", fmt.Sprintf("/v0/corps/%s/sites/%s/members/%s", corpName, siteName, email), "")
 
 	return err
 }
 
 // InviteSiteMember invites a new user to a site by email.
 func (sc *Client) InviteSiteMember(corpName, siteName, email string, body SiteMemberBody) (SiteMemberResponse, error) {
+	return sc.InviteSiteMemberContext(context.Background(), corpName, siteName, email, body)
+}
+
+// InviteSiteMemberContext is like InviteSiteMember but uses the given context.
+func (sc *Client) InviteSiteMemberContext(ctx context.Context, corpName, siteName, email string, body SiteMemberBody) (SiteMemberResponse, error) {
 	b, err := json.Marshal(body)
 	if err != nil {
 		return SiteMemberResponse{}, err
 	}
 
-	resp, err := sc.doRequest("POST", fmt.Sprintf("/v0/corps/%s/sites/%s/members/%s/invite", corpName, siteName, email), string(b))
+	resp, err := sc.doRequest(ctx, "POST", fmt.Sprintf("/v0/corps/%s/sites/%s/members/%s/invite", corpName, siteName, email), string(b))
 	if err != nil {
 		return SiteMemberResponse{}, err
 	}
@@ -1458,7 +2107,12 @@ type SiteMonitor struct {
 
 // GetSiteMonitor gets the site monitor URL.
 func (sc *Client) GetSiteMonitor(corpName, siteName, email string) (SiteMonitor, error) {
-	resp, err := sc.doRequest("GET", fmt.Sprintf("/v0/corps/%s/sites/%s/monitors", corpName, siteName), "")
+	return sc.GetSiteMonitorContext(context.Background(), corpName, siteName, email)
+}
+
+// GetSiteMonitorContext is like GetSiteMonitor but uses the given context.
+func (sc *Client) GetSiteMonitorContext(ctx context.Context, corpName, siteName, email string) (SiteMonitor, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/sites/%s/monitors", corpName, siteName), "")
 	if err != nil {
 		return SiteMonitor{}, err
 	}
@@ -1474,7 +2128,12 @@ func (sc *Client) GetSiteMonitor(corpName, siteName, email string) (SiteMonitor,
 
 // GenerateSiteMonitor generates a site monitor URL.
 func (sc *Client) GenerateSiteMonitor(corpName, siteName string) (SiteMonitor, error) {
-	resp, err := sc.doRequest("POST", fmt.Sprintf("/v0/corps/%s/sites/%s/monitors", corpName, siteName), "")
+	return sc.GenerateSiteMonitorContext(context.Background(), corpName, siteName)
+}
+
+// GenerateSiteMonitorContext is like GenerateSiteMonitor but uses the given context.
+func (sc *Client) GenerateSiteMonitorContext(ctx context.Context, corpName, siteName string) (SiteMonitor, error) {
+	resp, err := sc.doRequest(ctx, "POST", fmt.Sprintf("/v0/corps/%s/sites/%s/monitors", corpName, siteName), "")
 	if err != nil {
 		return SiteMonitor{}, err
 	}
@@ -1490,14 +2149,24 @@ func (sc *Client) GenerateSiteMonitor(corpName, siteName string) (SiteMonitor, e
 
 // EnableSiteMonitor enables the site monitor URL for a given site.
 func (sc *Client) EnableSiteMonitor(corpName, siteName string) error {
-	_, err := sc.doRequest("POST", fmt.Sprintf("/v0/corps/%s/sites/%s/monitors/enable", corpName, siteName), "")
+	return sc.EnableSiteMonitorContext(context.Background(), corpName, siteName)
+}
+
+// EnableSiteMonitorContext is like EnableSiteMonitor but uses the given context.
+func (sc *Client) EnableSiteMonitorContext(ctx context.Context, corpName, siteName string) error {
+	_, err := sc.doRequest(ctx, "POST", fmt.Sprintf("/v0/corps/%s/sites/%s/monitors/enable", corpName, siteName), "")
 
 	return err
 }
 
 // DisableSiteMonitor disables the site monitor URL for a given site.
 func (sc *Client) DisableSiteMonitor(corpName, siteName string) error {
-	_, err := sc.doRequest("POST", fmt.Sprintf("/v0/corps/%s/sites/%s/monitors/disable", corpName, siteName), "")
+	return sc.DisableSiteMonitorContext(context.Background(), corpName, siteName)
+}
+
+// DisableSiteMonitorContext is like DisableSiteMonitor but uses the given context.
+func (sc *Client) DisableSiteMonitorContext(ctx context.Context, corpName, siteName string) error {
+	_, err := sc.doRequest(ctx, "POST", fmt.Sprintf("/v0/corps/%s/sites/%s/monitors/disable", corpName, siteName), "")
 
 	return err
 }
@@ -1567,7 +2236,12 @@ type agentsResponse struct {
 
 // ListAgents lists agents for a given corp and site.
 func (sc *Client) ListAgents(corpName, siteName string) ([]Agent, error) {
-	resp, err := sc.doRequest("GET", fmt.Sprintf("/v0/corps/%s/sites/%s/agents", corpName, siteName), "")
+	return sc.ListAgentsContext(context.Background(), corpName, siteName)
+}
+
+// ListAgentsContext is like ListAgents but uses the given context.
+func (sc *Client) ListAgentsContext(ctx context.Context, corpName, siteName string) ([]Agent, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/sites/%s/agents", corpName, siteName), "")
 	if err != nil {
 		return []Agent{}, err
 	}
@@ -1583,7 +2257,12 @@ func (sc *Client) ListAgents(corpName, siteName string) ([]Agent, error) {
 
 // GetAgent gets an agent by name.
 func (sc *Client) GetAgent(corpName, siteName, agentName string) (Agent, error) {
-	resp, err := sc.doRequest("GET", fmt.Sprintf("/v0/corps/%s/sites/%s/agents/%s", corpName, siteName, agentName), "")
+	return sc.GetAgentContext(context.Background(), corpName, siteName, agentName)
+}
+
+// GetAgentContext is like GetAgent but uses the given context.
+func (sc *Client) GetAgentContext(ctx context.Context, corpName, siteName, agentName string) (Agent, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/sites/%s/agents/%s", corpName, siteName, agentName), "")
 	if err != nil {
 		return Agent{}, err
 	}
@@ -1614,7 +2293,12 @@ type agentLogsResponse struct {
 
 // GetAgentLogs gets agent logs for a given agent.
 func (sc *Client) GetAgentLogs(corpName, siteName, agentName string) ([]AgentLog, error) {
-	resp, err := sc.doRequest("GET", fmt.Sprintf("/v0/corps/%s/sites/%s/agents/%s/logs", corpName, siteName, agentName), "")
+	return sc.GetAgentLogsContext(context.Background(), corpName, siteName, agentName)
+}
+
+// GetAgentLogsContext is like GetAgentLogs but uses the given context.
+func (sc *Client) GetAgentLogsContext(ctx context.Context, corpName, siteName, agentName string) ([]AgentLog, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/sites/%s/agents/%s/logs", corpName, siteName, agentName), "")
 	if err != nil {
 		return []AgentLog{}, err
 	}
@@ -1647,7 +2331,12 @@ type suspiciousIPSResponse struct {
 
 // ListSuspiciousIPs lists suspicious IPs.
 func (sc *Client) ListSuspiciousIPs(corpName, siteName string) ([]SuspiciousIP, error) {
-	resp, err := sc.doRequest("GET", fmt.Sprintf("/v0/corps/%s/sites/%s/suspiciousIPs", corpName, siteName), "")
+	return sc.ListSuspiciousIPsContext(context.Background(), corpName, siteName)
+}
+
+// ListSuspiciousIPsContext is like ListSuspiciousIPs but uses the given context.
+func (sc *Client) ListSuspiciousIPsContext(ctx context.Context, corpName, siteName string) ([]SuspiciousIP, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/sites/%s/suspiciousIPs", corpName, siteName), "")
 	if err != nil {
 		return []SuspiciousIP{}, err
 	}
@@ -1675,11 +2364,16 @@ type topAttacksResponse struct {
 
 // ListTopAttacks lists top attacks.
 func (sc *Client) ListTopAttacks(corpName, siteName string, query url.Values) ([]TopAttack, error) {
+	return sc.ListTopAttacksContext(context.Background(), corpName, siteName, query)
+}
+
+// ListTopAttacksContext is like ListTopAttacks but uses the given context.
+func (sc *Client) ListTopAttacksContext(ctx context.Context, corpName, siteName string, query url.Values) ([]TopAttack, error) {
 	url := fmt.Sprintf("/v0/corps/%s/sites/%s/top/attacks", corpName, siteName)
 	if query.Encode() != "" {
 		url += "?" + query.Encode()
 	}
-	resp, err := sc.doRequest("GET", url, "")
+	resp, err := sc.doRequest(ctx, "GET", url, "")
 	if err != nil {
 		return []TopAttack{}, err
 	}
@@ -1710,11 +2404,16 @@ type timeseriesResponse struct {
 
 // GetTimeseries gets timeseries request info.
 func (sc *Client) GetTimeseries(corpName, siteName string, query url.Values) ([]Timeseries, error) {
+	return sc.GetTimeseriesContext(context.Background(), corpName, siteName, query)
+}
+
+// GetTimeseriesContext is like GetTimeseries but uses the given context.
+func (sc *Client) GetTimeseriesContext(ctx context.Context, corpName, siteName string, query url.Values) ([]Timeseries, error) {
 	url := fmt.Sprintf("/v0/corps/%s/sites/%s/timeseries/requests", corpName, siteName)
 	if query.Encode() != "" {
 		url += "?" + query.Encode()
 	}
-	resp, err := sc.doRequest("GET", url, "")
+	resp, err := sc.doRequest(ctx, "GET", url, "")
 	if err != nil {
 		return []Timeseries{}, err
 	}
@@ -1,7 +1,14 @@
 package sigsci
 
 import (
+	"context"
+	"io/ioutil"
 	"log"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
 )
 
 func ExampleClient_InviteUser() {
@@ -21,3 +28,203 @@ func ExampleClient_InviteUser() {
 		log.Fatal(err)
 	}
 }
+
+func ExampleClient_ListAgentsContext() {
+	sc := NewTokenClient("[email]", "[token]")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	agents, err := sc.ListAgentsContext(ctx, "testcorp", "www.mysite.com")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	log.Println(agents)
+}
+
+func ExampleClient_UpdateCorpUser() {
+	sc := NewTokenClient("[email]", "[token]")
+
+	update := NewCorpUserInvite(RoleCorpUser, []SiteMembership{
+		NewSiteMembership("www.mysite.com", RoleSiteAdmin),
+		NewSiteMembership("www.othersite.com", RoleSiteObserver),
+	})
+
+	_, err := sc.UpdateCorpUser("testcorp", "test@test.net", update)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// requestTest is a client call, the request it should send and the
+// result it should decode from the response.
+type requestTest struct {
+	name     string
+	call     func(sc *Client) (interface{}, error)
+	method   string
+	path     string
+	body     string
+	response string // empty for 204 No Content
+	want     interface{}
+}
+
+func runRequestTests(t *testing.T, tests []requestTest) {
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, _ := ioutil.ReadAll(r.Body)
+				if r.Method != tt.method || r.URL.Path != tt.path {
+					t.Errorf("got %s %s, want %s %s", r.Method, r.URL.Path, tt.method, tt.path)
+				}
+				if string(b) != tt.body {
+					t.Errorf("got body %s, want %s", b, tt.body)
+				}
+				if tt.response == "" {
+					w.WriteHeader(http.StatusNoContent)
+					return
+				}
+				w.Write([]byte(tt.response))
+			}))
+			defer ts.Close()
+
+			sc := NewTokenClient("test@test.net", "token", WithBaseURL(ts.URL))
+			got, err := tt.call(&sc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateDeleteSite(t *testing.T) {
+	runRequestTests(t, []requestTest{
+		{
+			name: "create",
+			call: func(sc *Client) (interface{}, error) {
+				return sc.CreateSite("testcorp", CreateSiteBody{
+					Name:                 "www.newsite.com",
+					DisplayName:          "New site",
+					AgentLevel:           "block",
+					BlockHTTPCode:        406,
+					BlockDurationSeconds: 3600,
+				})
+			},
+			method:   "POST",
+			path:     "/v0/corps/testcorp/sites",
+			body:     `{"name":"www.newsite.com","displayName":"New site","agentLevel":"block","blockHTTPCode":406,"blockDurationSeconds":3600}`,
+			response: `{"name":"www.newsite.com","displayName":"New site","agentLevel":"block","blockHTTPCode":406,"blockDurationSeconds":3600}`,
+			want: Site{
+				Name:                 "www.newsite.com",
+				DisplayName:          "New site",
+				AgentLevel:           "block",
+				BlockHTTPCode:        406,
+				BlockDurationSeconds: 3600,
+			},
+		},
+		{
+			name: "create defaults",
+			call: func(sc *Client) (interface{}, error) {
+				return sc.CreateSite("testcorp", CreateSiteBody{Name: "www.newsite.com", DisplayName: "New site"})
+			},
+			method:   "POST",
+			path:     "/v0/corps/testcorp/sites",
+			body:     `{"name":"www.newsite.com","displayName":"New site"}`,
+			response: `{"name":"www.newsite.com","displayName":"New site","agentLevel":"log"}`,
+			want:     Site{Name: "www.newsite.com", DisplayName: "New site", AgentLevel: "log"},
+		},
+		{
+			name: "delete",
+			call: func(sc *Client) (interface{}, error) {
+				return nil, sc.DeleteSite("testcorp", "www.newsite.com")
+			},
+			method: "DELETE",
+			path:   "/v0/corps/testcorp/sites/www.newsite.com",
+		},
+	})
+}
+
+func TestParamsAndPaths(t *testing.T) {
+	runRequestTests(t, []requestTest{
+		{
+			name: "add param",
+			call: func(sc *Client) (interface{}, error) {
+				return sc.AddParam("testcorp", "www.mysite.com", ParamBody{Name: "token", Type: "ignore", Note: "csrf"})
+			},
+			method:   "POST",
+			path:     "/v0/corps/testcorp/sites/www.mysite.com/paramwhitelist",
+			body:     `{"name":"token","type":"ignore","note":"csrf"}`,
+			response: `{"id":"p1","name":"token","type":"ignore","note":"csrf"}`,
+			want:     Param{ID: "p1", Name: "token", Type: "ignore", Note: "csrf"},
+		},
+		{
+			name: "get param",
+			call: func(sc *Client) (interface{}, error) {
+				return sc.GetParam("testcorp", "www.mysite.com", "p1")
+			},
+			method:   "GET",
+			path:     "/v0/corps/testcorp/sites/www.mysite.com/paramwhitelist/p1",
+			response: `{"id":"p1","name":"token","type":"ignore","note":"csrf","createdBy":"test@test.net"}`,
+			want:     Param{ID: "p1", Name: "token", Type: "ignore", Note: "csrf", CreatedBy: "test@test.net"},
+		},
+		{
+			name: "delete param",
+			call: func(sc *Client) (interface{}, error) {
+				return nil, sc.DeleteParam("testcorp", "www.mysite.com", "p1")
+			},
+			method: "DELETE",
+			path:   "/v0/corps/testcorp/sites/www.mysite.com/paramwhitelist/p1",
+		},
+		{
+			name: "add path",
+			call: func(sc *Client) (interface{}, error) {
+				return sc.AddPath("testcorp", "www.mysite.com", PathBody{Path: "/upload", Note: "binary"})
+			},
+			method:   "POST",
+			path:     "/v0/corps/testcorp/sites/www.mysite.com/pathwhitelist",
+			body:     `{"path":"/upload","note":"binary"}`,
+			response: `{"id":"p2","path":"/upload","note":"binary"}`,
+			want:     Path{ID: "p2", Path: "/upload", Note: "binary"},
+		},
+		{
+			name: "get path",
+			call: func(sc *Client) (interface{}, error) {
+				return sc.GetPath("testcorp", "www.mysite.com", "p2")
+			},
+			method:   "GET",
+			path:     "/v0/corps/testcorp/sites/www.mysite.com/pathwhitelist/p2",
+			response: `{"id":"p2","path":"/upload","note":"binary"}`,
+			want:     Path{ID: "p2", Path: "/upload", Note: "binary"},
+		},
+		{
+			name: "delete path",
+			call: func(sc *Client) (interface{}, error) {
+				return nil, sc.DeletePath("testcorp", "www.mysite.com", "p2")
+			},
+			method: "DELETE",
+			path:   "/v0/corps/testcorp/sites/www.mysite.com/pathwhitelist/p2",
+		},
+	})
+}
+
+func TestHeaderLinkBodyValidate(t *testing.T) {
+	tests := []struct {
+		body    HeaderLinkBody
+		wantErr bool
+	}{
+		{HeaderLinkBody{Type: HeaderLinkTypeRequest, Name: "X-Request-Id", LinkName: "Trace", Link: "https://trace.example.com/{{value}}"}, false},
+		{HeaderLinkBody{Type: HeaderLinkTypeResponse, Name: "X-Request-Id", Link: "https://trace.example.com/?id={{value}}"}, false},
+		{HeaderLinkBody{Type: "both", Name: "X-Request-Id", Link: "https://trace.example.com/{{value}}"}, true},
+		{HeaderLinkBody{Type: HeaderLinkTypeRequest, Link: "https://trace.example.com/{{value}}"}, true},
+		{HeaderLinkBody{Type: HeaderLinkTypeRequest, Name: "X-Request-Id", Link: "https://trace.example.com/{value}"}, true},
+	}
+
+	for i, tt := range tests {
+		if err := tt.body.Validate(); (err != nil) != tt.wantErr {
+			t.Errorf("%d: got error %v, want error %v", i, err, tt.wantErr)
+		}
+	}
+}
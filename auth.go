@@ -0,0 +1,127 @@
+package sigsci
+
+import (
+	"context"
+	"sync"
+)
+
+// session is the login session of a client created with NewClient. It is
+// shared by copies of the client, so that they log in again only once
+// when the session token expires.
+type session struct {
+	email    string
+	password string
+
+	mu        sync.Mutex
+	token     string
+	gen       int           // incremented on each login
+	login     *sessionLogin // login in progress, if any
+	loggedOut bool
+}
+
+// sessionLogin is a login in progress. Its done channel is closed when it
+// finishes.
+type sessionLogin struct {
+	done     chan struct{}
+	err      error
+	canceled bool // err is due to the context of the request logging in
+}
+
+// sessionToken returns the token to authenticate with and, for password
+// clients, the generation of the session it belongs to.
+func (sc *Client) sessionToken() (string, int) {
+	s := sc.session
+	if s == nil {
+		return sc.token, 0
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.token, s.gen
+}
+
+// reauthenticate logs in again after a request authenticated with the
+// session token of generation gen was unauthorized, unless another
+// request has logged in since. Only one request logs in at a time; the
+// others wait for it or for their context to be done. It reports whether
+// the request should be retried.
+func (sc *Client) reauthenticate(ctx context.Context, gen int) (bool, error) {
+	s := sc.session
+
+	for {
+		s.mu.Lock()
+		if s.loggedOut {
+			s.mu.Unlock()
+			return false, nil
+		}
+		if s.gen != gen {
+			s.mu.Unlock()
+			return true, nil
+		}
+		l := s.login
+		if l == nil {
+			l = &sessionLogin{done: make(chan struct{})}
+			s.login = l
+			s.mu.Unlock()
+			return sc.login(ctx, l)
+		}
+		s.mu.Unlock()
+
+		select {
+		case <-l.done:
+		case <-ctx.Done():
+			return false, ctx.Err()
+		}
+
+		// If the login failed because the context of the request that
+		// made it was done, try again with this request's context.
+		if l.err != nil && !l.canceled {
+			return false, l.err
+		}
+	}
+}
+
+// login logs in for the in-flight login l and wakes up the requests
+// waiting for it. It reports whether the request should be retried.
+func (sc *Client) login(ctx context.Context, l *sessionLogin) (bool, error) {
+	s := sc.session
+	token, err := sc.authenticate(ctx, s.email, s.password)
+
+	s.mu.Lock()
+	retry := err == nil && !s.loggedOut
+	if retry {
+		s.token = token
+		s.gen++
+	}
+	s.login = nil
+	l.err = err
+	l.canceled = ctx.Err() != nil
+	s.mu.Unlock()
+	close(l.done)
+
+	return retry, err
+}
+
+// Logout ends the session of a client created with NewClient. The client
+// does not log in again afterwards.
+func (sc *Client) Logout() error {
+	return sc.LogoutContext(context.Background())
+}
+
+// LogoutContext is like Logout but uses the given context.
+func (sc *Client) LogoutContext(ctx context.Context) error {
+	_, err := sc.doRequest(ctx, "GET", "/v0/auth/logout", "")
+	if err != nil {
+		return err
+	}
+
+	if s := sc.session; s != nil {
+		s.mu.Lock()
+		s.token = ""
+		s.loggedOut = true
+		s.mu.Unlock()
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+package sigsci
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestReauthenticate(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		logins int
+		token  string
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch r.URL.Path {
+		case "/v0/auth":
+			if r.FormValue("password") != "secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			logins++
+			token = "token" + strconv.Itoa(logins)
+			w.Write([]byte(`{"token":"` + token + `"}`))
+		case "/v0/auth/logout":
+			token = ""
+			w.Write([]byte(`{}`))
+		case "/v0/corps":
+			if token == "" || r.Header.Get("Authorization") != "Bearer "+token {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Write([]byte(`{"data":[]}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer ts.Close()
+
+	sc, err := NewClient("test@test.net", "secret", WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Expire the session token.
+	mu.Lock()
+	token = "expired"
+	mu.Unlock()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := sc.ListCorps(); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if logins != 2 {
+		t.Errorf("got %d logins, want 2", logins)
+	}
+
+	if err := sc.Logout(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sc.ListCorps(); !IsUnauthorized(err) {
+		t.Errorf("got %v after logout, want unauthorized", err)
+	}
+	if logins != 2 {
+		t.Errorf("got %d logins after logout, want 2", logins)
+	}
+}
+
+func TestReauthenticateCanceled(t *testing.T) {
+	var (
+		mu        sync.Mutex
+		logins    int
+		expired   int
+		loggingIn = make(chan struct{})
+		release   = make(chan struct{})
+		rejected  = make(chan struct{}, 2)
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v0/auth":
+			mu.Lock()
+			logins++
+			n := logins
+			mu.Unlock()
+			if n > 1 {
+				// Block the second login until the test releases it.
+				close(loggingIn)
+				<-release
+			}
+			w.Write([]byte(`{"token":"token` + strconv.Itoa(n) + `"}`))
+		case "/v0/corps":
+			mu.Lock()
+			defer mu.Unlock()
+			if r.Header.Get("Authorization") != "Bearer token2" {
+				expired++
+				w.WriteHeader(http.StatusUnauthorized)
+				rejected <- struct{}{}
+				return
+			}
+			w.Write([]byte(`{"data":[]}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer ts.Close()
+
+	sc, err := NewClient("test@test.net", "secret", WithBaseURL(ts.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The first call logs in again, which blocks.
+	first := make(chan error, 1)
+	go func() {
+		_, err := sc.ListCorps()
+		first <- err
+	}()
+	<-rejected
+	<-loggingIn
+
+	// The second call waits for the login until its context is canceled.
+	ctx, cancel := context.WithCancel(context.Background())
+	second := make(chan error, 1)
+	go func() {
+		_, err := sc.ListCorpsContext(ctx)
+		second <- err
+	}()
+	select {
+	case <-rejected:
+	case <-time.After(time.Second):
+	}
+	cancel()
+
+	select {
+	case err := <-second:
+		if err == nil {
+			t.Error("expected error from canceled call")
+		}
+	case <-time.After(time.Second):
+		t.Error("canceled call is blocked by the login")
+	}
+
+	close(release)
+	if err := <-first; err != nil {
+		t.Fatal(err)
+	}
+	if logins != 2 || expired != 2 {
+		t.Errorf("got %d logins and %d expired requests, want 2 and 2", logins, expired)
+	}
+}
@@ -0,0 +1,102 @@
+package sigsci
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// APIError is returned when the API responds with an unexpected status code.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Method and Path identify the API call, e.g. "GET" and
+	// "/v0/corps/testcorp/sites".
+	Method string
+	Path   string
+	// Message is the error message returned by the API, if the response
+	// body contained one.
+	Message string
+	// RequestID is the value of the X-Request-Id response header, if any.
+	RequestID string
+	// Header contains the response headers.
+	Header http.Header
+	// Body is the raw response body.
+	Body []byte
+}
+
+func (e *APIError) Error() string {
+	msg := e.Message
+	if msg == "" {
+		msg = http.StatusText(e.StatusCode)
+	}
+
+	return fmt.Sprintf("%s %s: %d: %s", e.Method, e.Path, e.StatusCode, msg)
+}
+
+// newAPIError builds an APIError from a response and its body. The body
+// is not required to be JSON, e.g. for an HTML 502 from a load balancer.
+func newAPIError(resp *http.Response, method, path string, body []byte) *APIError {
+	var errResp struct {
+		Message string
+	}
+	// Ignore the error so that non-JSON bodies still report the status.
+	_ = json.Unmarshal(body, &errResp)
+
+	return &APIError{
+		StatusCode: resp.StatusCode,
+		Method:     method,
+		Path:       path,
+		Message:    errResp.Message,
+		RequestID:  resp.Header.Get("X-Request-Id"),
+		Header:     resp.Header,
+		Body:       body,
+	}
+}
+
+// IsNotFound reports whether err is or wraps an APIError with status 404.
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsUnauthorized reports whether err is or wraps an APIError with status 401.
+func IsUnauthorized(err error) bool {
+	return hasStatus(err, http.StatusUnauthorized)
+}
+
+// IsForbidden reports whether err is or wraps an APIError with status 403.
+func IsForbidden(err error) bool {
+	return hasStatus(err, http.StatusForbidden)
+}
+
+// IsRateLimited reports whether err is or wraps an APIError with status 429.
+func IsRateLimited(err error) bool {
+	return hasStatus(err, http.StatusTooManyRequests)
+}
+
+// IsServerError reports whether err is or wraps an APIError with a 5xx status.
+func IsServerError(err error) bool {
+	e, ok := asAPIError(err)
+	return ok && e.StatusCode >= 500 && e.StatusCode <= 599
+}
+
+func hasStatus(err error, code int) bool {
+	e, ok := asAPIError(err)
+	return ok && e.StatusCode == code
+}
+
+// asAPIError returns the APIError in err's chain of Unwrap methods, if any.
+func asAPIError(err error) (*APIError, bool) {
+	for err != nil {
+		if e, ok := err.(*APIError); ok {
+			return e, true
+		}
+		u, ok := err.(interface{ Unwrap() error })
+		if !ok {
+			break
+		}
+		err = u.Unwrap()
+	}
+
+	return nil, false
+}
@@ -0,0 +1,59 @@
+package sigsci
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAPIError(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		wantMessage string
+		is          func(error) bool
+	}{
+		{"json", http.StatusNotFound, `{"message":"Site not found"}`, "Site not found", IsNotFound},
+		{"html", http.StatusBadGateway, `<html>Bad Gateway</html>`, "", IsServerError},
+		{"rate limited", http.StatusTooManyRequests, `{"message":"Too many requests"}`, "Too many requests", IsRateLimited},
+		{"unauthorized", http.StatusUnauthorized, ``, "", IsUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("X-Request-Id", "abc123")
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer ts.Close()
+
+			sc := NewTokenClient("test@test.net", "token", WithBaseURL(ts.URL))
+			_, err := sc.GetSite("testcorp", "www.mysite.com")
+
+			e, ok := err.(*APIError)
+			if !ok {
+				t.Fatalf("got %T (%v), want *APIError", err, err)
+			}
+			if e.StatusCode != tt.status {
+				t.Errorf("got status %d, want %d", e.StatusCode, tt.status)
+			}
+			if e.Message != tt.wantMessage {
+				t.Errorf("got message %q, want %q", e.Message, tt.wantMessage)
+			}
+			if e.Method != "GET" || e.Path != "/v0/corps/testcorp/sites/www.mysite.com" {
+				t.Errorf("got %s %s", e.Method, e.Path)
+			}
+			if e.RequestID != "abc123" {
+				t.Errorf("got request id %q", e.RequestID)
+			}
+			if string(e.Body) != tt.body {
+				t.Errorf("got body %q, want %q", e.Body, tt.body)
+			}
+			if !tt.is(err) {
+				t.Errorf("status helper returned false for %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,245 @@
+package sigsci
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// FeedCheckpoint records the progress of a FeedTailer.
+type FeedCheckpoint struct {
+	// From and Until are the bounds of the feed window being read.
+	From  time.Time `json:"from"`
+	Until time.Time `json:"until"`
+	// Next is the next page of the window, or empty to start the window
+	// at its first page.
+	Next string `json:"next,omitempty"`
+	// Handled holds the IDs of the requests of the current page that have
+	// been handled, which are skipped when the page is read again.
+	Handled []string `json:"handled,omitempty"`
+}
+
+// CheckpointStore persists FeedTailer checkpoints.
+type CheckpointStore interface {
+	// Load returns the saved checkpoint, or a zero FeedCheckpoint if none
+	// has been saved.
+	Load(ctx context.Context) (FeedCheckpoint, error)
+	// Save saves the checkpoint.
+	Save(ctx context.Context, cp FeedCheckpoint) error
+}
+
+// FileCheckpointStore is a CheckpointStore that keeps the checkpoint in a
+// JSON file.
+type FileCheckpointStore struct {
+	Path string
+}
+
+// Load reads the checkpoint from the file.
+func (s FileCheckpointStore) Load(ctx context.Context) (FeedCheckpoint, error) {
+	b, err := ioutil.ReadFile(s.Path)
+	if os.IsNotExist(err) {
+		return FeedCheckpoint{}, nil
+	}
+	if err != nil {
+		return FeedCheckpoint{}, err
+	}
+
+	var cp FeedCheckpoint
+	err = json.Unmarshal(b, &cp)
+	if err != nil {
+		return FeedCheckpoint{}, err
+	}
+
+	return cp, nil
+}
+
+// Save writes the checkpoint to a temporary file and renames it over the
+// file, so that a crash never leaves a partially written checkpoint.
+func (s FileCheckpointStore) Save(ctx context.Context, cp FeedCheckpoint) error {
+	b, err := json.Marshal(cp)
+	if err != nil {
+		return err
+	}
+
+	f, err := ioutil.TempFile(filepath.Dir(s.Path), filepath.Base(s.Path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	_, err = f.Write(b)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), s.Path)
+}
+
+// memoryCheckpointStore keeps the checkpoint in memory.
+type memoryCheckpointStore struct {
+	cp FeedCheckpoint
+}
+
+func (s *memoryCheckpointStore) Load(ctx context.Context) (FeedCheckpoint, error) {
+	return s.cp, nil
+}
+
+func (s *memoryCheckpointStore) Save(ctx context.Context, cp FeedCheckpoint) error {
+	s.cp = cp
+	return nil
+}
+
+// FeedTailer continuously reads the request feed of a site in
+// minute-aligned windows and passes each request to a handler.
+//
+// Delivery is at-least-once: the checkpoint is saved after each page has
+// been handled, and when the handler returns an error, with the IDs of
+// the requests handled on the current page, so a FeedTailer restarted
+// from the checkpoint skips them. A crash while a page is being handled
+// delivers the requests of that page handled before the crash again.
+// Requests returned again on a later page or window are skipped by
+// Request.ID by the same FeedTailer.
+type FeedTailer struct {
+	// Interval is the size of each feed window and must be a whole number
+	// of minutes. It defaults to 1 minute.
+	Interval time.Duration
+	// Delay is how far in the past a window must end before it is read,
+	// giving the API time to make all requests of the window available.
+	// It defaults to 5 minutes.
+	Delay time.Duration
+	// Start is where to begin reading when the store has no checkpoint.
+	// It defaults to the most recent window that can be read.
+	Start time.Time
+
+	sc       *Client
+	corpName string
+	siteName string
+	store    CheckpointStore
+	now      func() time.Time
+
+	seen, prevSeen map[string]struct{}
+}
+
+// NewFeedTailer returns a FeedTailer for the request feed of the given
+// site. If store is nil, the checkpoint is kept in memory only.
+func NewFeedTailer(sc *Client, corpName, siteName string, store CheckpointStore) *FeedTailer {
+	if store == nil {
+		store = &memoryCheckpointStore{}
+	}
+
+	return &FeedTailer{
+		Interval: time.Minute,
+		Delay:    5 * time.Minute,
+		sc:       sc,
+		corpName: corpName,
+		siteName: siteName,
+		store:    store,
+		now:      time.Now,
+		seen:     map[string]struct{}{},
+	}
+}
+
+// Run reads the feed until ctx is done or handler returns an error, and
+// returns that error. The checkpoint is not advanced past a page whose
+// requests were not all handled successfully. Pages are followed by their
+// next URIs, including those of pages without requests, until the window
+// has no next page.
+func (t *FeedTailer) Run(ctx context.Context, handler func(Request) error) error {
+	if t.Interval < time.Minute || t.Interval%time.Minute != 0 {
+		return fmt.Errorf("feed tailer interval must be a whole number of minutes, got %v", t.Interval)
+	}
+
+	cp, err := t.store.Load(ctx)
+	if err != nil {
+		return err
+	}
+
+	if cp.From.IsZero() {
+		from := t.Start
+		if from.IsZero() {
+			from = t.now().Add(-t.Delay - t.Interval)
+		}
+		from = from.Truncate(time.Minute)
+		cp = FeedCheckpoint{From: from, Until: from.Add(t.Interval)}
+	}
+	for _, id := range cp.Handled {
+		t.seen[id] = struct{}{}
+	}
+
+	for {
+		if wait := cp.Until.Add(t.Delay).Sub(t.now()); wait > 0 {
+			if err := sleepContext(ctx, wait); err != nil {
+				return err
+			}
+		}
+
+		path := cp.Next
+		if path == "" {
+			query := url.Values{}
+			query.Set("from", strconv.FormatInt(cp.From.Unix(), 10))
+			query.Set("until", strconv.FormatInt(cp.Until.Unix(), 10))
+			path = withQuery(fmt.Sprintf("/v0/corps/%s/sites/%s/feed/requests", t.corpName, t.siteName), query)
+		}
+
+		resp, err := t.sc.doRequest(ctx, "GET", path, "")
+		if err != nil {
+			return err
+		}
+
+		var r requestFeedResponse
+		err = json.Unmarshal(resp, &r)
+		if err != nil {
+			return err
+		}
+
+		for _, req := range r.Data {
+			if t.delivered(req.ID) {
+				continue
+			}
+			if err := handler(req); err != nil {
+				// Save the requests handled so far, so that they are
+				// skipped when the page is read again. The handler's
+				// error takes precedence over an error saving them.
+				t.store.Save(ctx, cp)
+				return err
+			}
+			t.seen[req.ID] = struct{}{}
+			cp.Handled = append(cp.Handled, req.ID)
+		}
+
+		if next := nextPath(r.Next["uri"]); next != "" {
+			cp.Next = next
+			cp.Handled = nil
+		} else {
+			cp = FeedCheckpoint{From: cp.Until, Until: cp.Until.Add(t.Interval)}
+			// Keep the IDs of the previous window, in case requests
+			// near the window boundary are returned again.
+			t.prevSeen, t.seen = t.seen, map[string]struct{}{}
+		}
+
+		err = t.store.Save(ctx, cp)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// delivered reports whether the request with the given ID was already
+// passed to the handler.
+func (t *FeedTailer) delivered(id string) bool {
+	if _, ok := t.seen[id]; ok {
+		return true
+	}
+	_, ok := t.prevSeen[id]
+
+	return ok
+}
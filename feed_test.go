@@ -0,0 +1,205 @@
+package sigsci
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestFeedTailer(t *testing.T) {
+	now := time.Unix(1500000600, 0)
+	from := func(d time.Duration) string {
+		return strconv.FormatInt(now.Add(d).Unix(), 10)
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		switch {
+		case q.Get("page") == "2":
+			w.Write([]byte(`{"next":{"uri":""},"data":[{"id":"b"}]}`))
+		case q.Get("from") == from(-3*time.Minute) && q.Get("until") == from(-2*time.Minute):
+			w.Write([]byte(`{"next":{"uri":"/api/v0/corps/testcorp/sites/www.mysite.com/feed/requests?page=2"},"data":[{"id":"a"}]}`))
+		case q.Get("from") == from(-2*time.Minute):
+			w.Write([]byte(`{"next":{"uri":""},"data":[{"id":"b"},{"id":"c"}]}`))
+		default:
+			w.Write([]byte(`{"next":{"uri":""},"data":[]}`))
+		}
+	}))
+	defer ts.Close()
+
+	dir, err := ioutil.TempDir("", "sigsci")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	store := FileCheckpointStore{Path: filepath.Join(dir, "checkpoint.json")}
+
+	sc := NewTokenClient("test@test.net", "token", WithBaseURL(ts.URL+"/api"))
+	ft := NewFeedTailer(&sc, "testcorp", "www.mysite.com", store)
+	ft.Start = now.Add(-3 * time.Minute)
+	ft.Delay = 0
+	ft.now = func() time.Time { return now }
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var ids []string
+	err = ft.Run(ctx, func(r Request) error {
+		ids = append(ids, r.ID)
+		if r.ID == "c" {
+			cancel()
+		}
+		return nil
+	})
+	if err == nil {
+		t.Fatal("expected error after cancel")
+	}
+	if len(ids) != 3 || ids[0] != "a" || ids[1] != "b" || ids[2] != "c" {
+		t.Errorf("got ids %v, want [a b c]", ids)
+	}
+
+	cp, err := store.Load(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cp.From.Equal(now.Add(-time.Minute)) || cp.Next != "" {
+		t.Errorf("unexpected checkpoint %+v", cp)
+	}
+}
+
+func TestFeedTailerEmptyPageWithNext(t *testing.T) {
+	now := time.Unix(1500000600, 0)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("page") {
+		case "":
+			w.Write([]byte(`{"next":{"uri":"/api/v0/corps/testcorp/sites/www.mysite.com/feed/requests?page=2"},"data":[]}`))
+		case "2":
+			w.Write([]byte(`{"next":{"uri":""},"data":[{"id":"a"}]}`))
+		}
+	}))
+	defer ts.Close()
+
+	sc := NewTokenClient("test@test.net", "token", WithBaseURL(ts.URL+"/api"))
+	ft := NewFeedTailer(&sc, "testcorp", "www.mysite.com", nil)
+	ft.Start = now.Add(-2 * time.Minute)
+	ft.Delay = 0
+	ft.now = func() time.Time { return now }
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var ids []string
+	ft.Run(ctx, func(r Request) error {
+		ids = append(ids, r.ID)
+		cancel()
+		return nil
+	})
+	if len(ids) != 1 || ids[0] != "a" {
+		t.Errorf("got ids %v, want [a]", ids)
+	}
+}
+
+func TestFeedTailerRestart(t *testing.T) {
+	now := time.Unix(1500000600, 0)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"next":{"uri":""},"data":[{"id":"a"},{"id":"b"}]}`))
+	}))
+	defer ts.Close()
+
+	store := &memoryCheckpointStore{}
+	sc := NewTokenClient("test@test.net", "token", WithBaseURL(ts.URL+"/api"))
+
+	run := func(handler func(Request) error) error {
+		ft := NewFeedTailer(&sc, "testcorp", "www.mysite.com", store)
+		ft.Start = now.Add(-2 * time.Minute)
+		ft.Delay = 0
+		ft.now = func() time.Time { return now }
+		return ft.Run(context.Background(), handler)
+	}
+
+	errStop := errors.New("stop")
+	var ids []string
+	err := run(func(r Request) error {
+		if r.ID == "b" {
+			return errStop
+		}
+		ids = append(ids, r.ID)
+		return nil
+	})
+	if err != errStop {
+		t.Fatalf("got %v, want %v", err, errStop)
+	}
+
+	err = run(func(r Request) error {
+		ids = append(ids, r.ID)
+		return errStop
+	})
+	if err != errStop {
+		t.Fatalf("got %v, want %v", err, errStop)
+	}
+	if len(ids) != 2 || ids[0] != "a" || ids[1] != "b" {
+		t.Errorf("got ids %v, want [a b]", ids)
+	}
+}
+
+// countingCheckpointStore counts the checkpoints saved.
+type countingCheckpointStore struct {
+	memoryCheckpointStore
+	saves int
+}
+
+func (s *countingCheckpointStore) Save(ctx context.Context, cp FeedCheckpoint) error {
+	s.saves++
+	return s.memoryCheckpointStore.Save(ctx, cp)
+}
+
+func TestFeedTailerSavesPerPage(t *testing.T) {
+	now := time.Unix(1500000600, 0)
+
+	var calls int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Write([]byte(`{"next":{"uri":""},"data":[{"id":"a"},{"id":"b"},{"id":"c"}]}`))
+			return
+		}
+		w.Write([]byte(`{"next":{"uri":""},"data":[{"id":"d"},{"id":"e"}]}`))
+	}))
+	defer ts.Close()
+
+	store := &countingCheckpointStore{}
+	sc := NewTokenClient("test@test.net", "token", WithBaseURL(ts.URL+"/api"))
+	ft := NewFeedTailer(&sc, "testcorp", "www.mysite.com", store)
+	ft.Start = now.Add(-2 * time.Minute)
+	ft.Delay = 0
+	ft.now = func() time.Time { return now }
+
+	errStop := errors.New("stop")
+	err := ft.Run(context.Background(), func(r Request) error {
+		if r.ID == "e" {
+			return errStop
+		}
+		return nil
+	})
+	if err != errStop {
+		t.Fatalf("got %v, want %v", err, errStop)
+	}
+
+	// One save after the first page and one with the requests handled
+	// on the second page before the error.
+	if store.saves != 2 {
+		t.Errorf("got %d saves, want 2", store.saves)
+	}
+	if h := store.cp.Handled; len(h) != 1 || h[0] != "d" {
+		t.Errorf("got handled %v, want [d]", h)
+	}
+}
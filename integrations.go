@@ -0,0 +1,267 @@
+package sigsci
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// IntegrationType is the kind of an integration.
+type IntegrationType string
+
+// All available IntegrationTypes
+const (
+	IntegrationTypeGeneric        = IntegrationType("generic")
+	IntegrationTypeSlack          = IntegrationType("slack")
+	IntegrationTypeMailingList    = IntegrationType("mailingList")
+	IntegrationTypePagerDuty      = IntegrationType("pagerduty")
+	IntegrationTypeDatadog        = IntegrationType("datadog")
+	IntegrationTypeMicrosoftTeams = IntegrationType("microsoftTeams")
+	IntegrationTypeOpsGenie       = IntegrationType("opsgenie")
+	IntegrationTypeVictorOps      = IntegrationType("victorops")
+	IntegrationTypeJira           = IntegrationType("jira")
+)
+
+// IntegrationEvent is an event an integration subscribes to.
+type IntegrationEvent string
+
+// All available IntegrationEvents
+const (
+	// Site events
+	EventSiteDisplayNameChanged = IntegrationEvent("siteDisplayNameChanged")
+	EventSiteNameChanged        = IntegrationEvent("siteNameChanged")
+	EventLoggingModeChanged     = IntegrationEvent("loggingModeChanged")
+	EventAgentAnonModeChanged   = IntegrationEvent("agentAnonModeChanged")
+	EventFlag                   = IntegrationEvent("flag")
+	EventExpireFlag             = IntegrationEvent("expireFlag")
+	EventCreateCustomRedaction  = IntegrationEvent("createCustomRedaction")
+	EventUpdateCustomRedaction  = IntegrationEvent("updateCustomRedaction")
+	EventRemoveCustomRedaction  = IntegrationEvent("removeCustomRedaction")
+	EventCustomTagCreated       = IntegrationEvent("customTagCreated")
+	EventCustomTagUpdated       = IntegrationEvent("customTagUpdated")
+	EventCustomTagDeleted       = IntegrationEvent("customTagDeleted")
+	EventCustomAlertCreated     = IntegrationEvent("customAlertCreated")
+	EventCustomAlertUpdated     = IntegrationEvent("customAlertUpdated")
+	EventCustomAlertDeleted     = IntegrationEvent("customAlertDeleted")
+	EventDetectionCreated       = IntegrationEvent("detectionCreated")
+	EventDetectionUpdated       = IntegrationEvent("detectionUpdated")
+	EventDetectionDeleted       = IntegrationEvent("detectionDeleted")
+	EventAgentAlert             = IntegrationEvent("agentAlert")
+
+	// Site and corp events
+	EventListCreated = IntegrationEvent("listCreated")
+	EventListUpdated = IntegrationEvent("listUpdated")
+	EventListDeleted = IntegrationEvent("listDeleted")
+	EventRuleCreated = IntegrationEvent("ruleCreated")
+	EventRuleUpdated = IntegrationEvent("ruleUpdated")
+	EventRuleDeleted = IntegrationEvent("ruleDeleted")
+
+	// Corp events
+	EventCorpUpdated        = IntegrationEvent("corpUpdated")
+	EventNewSite            = IntegrationEvent("newSite")
+	EventDeleteSite         = IntegrationEvent("deleteSite")
+	EventEnableSSO          = IntegrationEvent("enableSSO")
+	EventDisableSSO         = IntegrationEvent("disableSSO")
+	EventCorpUserInvited    = IntegrationEvent("corpUserInvited")
+	EventCorpUserReinvited  = IntegrationEvent("corpUserReinvited")
+	EventUserRemovedCorp    = IntegrationEvent("userRemovedCorp")
+	EventAccessTokenCreated = IntegrationEvent("accessTokenCreated")
+	EventAccessTokenDeleted = IntegrationEvent("accessTokenDeleted")
+	EventCorpSignalCreated  = IntegrationEvent("corpSignalCreated")
+	EventCorpSignalUpdated  = IntegrationEvent("corpSignalUpdated")
+	EventCorpSignalDeleted  = IntegrationEvent("corpSignalDeleted")
+)
+
+// siteIntegrationEvents are the events site integrations can subscribe to.
+var siteIntegrationEvents = map[IntegrationEvent]bool{
+	EventSiteDisplayNameChanged: true,
+	EventSiteNameChanged:        true,
+	EventLoggingModeChanged:     true,
+	EventAgentAnonModeChanged:   true,
+	EventFlag:                   true,
+	EventExpireFlag:             true,
+	EventCreateCustomRedaction:  true,
+	EventUpdateCustomRedaction:  true,
+	EventRemoveCustomRedaction:  true,
+	EventCustomTagCreated:       true,
+	EventCustomTagUpdated:       true,
+	EventCustomTagDeleted:       true,
+	EventCustomAlertCreated:     true,
+	EventCustomAlertUpdated:     true,
+	EventCustomAlertDeleted:     true,
+	EventDetectionCreated:       true,
+	EventDetectionUpdated:       true,
+	EventDetectionDeleted:       true,
+	EventAgentAlert:             true,
+	EventListCreated:            true,
+	EventListUpdated:            true,
+	EventListDeleted:            true,
+	EventRuleCreated:            true,
+	EventRuleUpdated:            true,
+	EventRuleDeleted:            true,
+}
+
+// corpIntegrationEvents are the events corp integrations can subscribe to.
+var corpIntegrationEvents = map[IntegrationEvent]bool{
+	EventListCreated:        true,
+	EventListUpdated:        true,
+	EventListDeleted:        true,
+	EventRuleCreated:        true,
+	EventRuleUpdated:        true,
+	EventRuleDeleted:        true,
+	EventCorpUpdated:        true,
+	EventNewSite:            true,
+	EventDeleteSite:         true,
+	EventEnableSSO:          true,
+	EventDisableSSO:         true,
+	EventCorpUserInvited:    true,
+	EventCorpUserReinvited:  true,
+	EventUserRemovedCorp:    true,
+	EventAccessTokenCreated: true,
+	EventAccessTokenDeleted: true,
+	EventCorpSignalCreated:  true,
+	EventCorpSignalUpdated:  true,
+	EventCorpSignalDeleted:  true,
+}
+
+// Validate checks the body of a site integration for an unknown type or
+// events that site integrations cannot subscribe to.
+func (b IntegrationBody) Validate() error {
+	return b.validate(siteIntegrationEvents)
+}
+
+// ValidateCorp checks the body of a corp integration for an unknown type
+// or events that corp integrations cannot subscribe to.
+func (b IntegrationBody) ValidateCorp() error {
+	return b.validate(corpIntegrationEvents)
+}
+
+func (b IntegrationBody) validate(events map[IntegrationEvent]bool) error {
+	switch b.Type {
+	case IntegrationTypeGeneric, IntegrationTypeSlack, IntegrationTypeMailingList,
+		IntegrationTypePagerDuty, IntegrationTypeDatadog, IntegrationTypeMicrosoftTeams,
+		IntegrationTypeOpsGenie, IntegrationTypeVictorOps, IntegrationTypeJira:
+	default:
+		return fmt.Errorf("invalid integration type %q", b.Type)
+	}
+
+	if b.URL == "" {
+		return errors.New("integration requires a url")
+	}
+
+	if len(b.Events) == 0 {
+		return errors.New("integration requires at least one event")
+	}
+
+	return validateIntegrationEvents(b.Events, events)
+}
+
+func validateIntegrationEvents(events []IntegrationEvent, valid map[IntegrationEvent]bool) error {
+	for _, e := range events {
+		if !valid[e] {
+			return fmt.Errorf("invalid integration event %q", e)
+		}
+	}
+
+	return nil
+}
+
+// ListCorpIntegrations lists corp integrations.
+func (sc *Client) ListCorpIntegrations(corpName string) ([]Integration, error) {
+	return sc.ListCorpIntegrationsContext(context.Background(), corpName)
+}
+
+// ListCorpIntegrationsContext is like ListCorpIntegrations but uses the given context.
+func (sc *Client) ListCorpIntegrationsContext(ctx context.Context, corpName string) ([]Integration, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/integrations", corpName), "")
+	if err != nil {
+		return []Integration{}, err
+	}
+
+	var ir integrationsResponse
+	err = json.Unmarshal(resp, &ir)
+	if err != nil {
+		return []Integration{}, err
+	}
+
+	return ir.Data, nil
+}
+
+// AddCorpIntegration adds a corp integration. The body is not validated,
+// so that types and events added to the API can be used; call
+// ValidateCorp to check it first.
+func (sc *Client) AddCorpIntegration(corpName string, body IntegrationBody) ([]Integration, error) {
+	return sc.AddCorpIntegrationContext(context.Background(), corpName, body)
+}
+
+// AddCorpIntegrationContext is like AddCorpIntegration but uses the given context.
+func (sc *Client) AddCorpIntegrationContext(ctx context.Context, corpName string, body IntegrationBody) ([]Integration, error) {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return []Integration{}, err
+	}
+
+	resp, err := sc.doRequest(ctx, "POST", fmt.Sprintf("/v0/corps/%s/integrations", corpName), string(b))
+	if err != nil {
+		return []Integration{}, err
+	}
+
+	var ir integrationsResponse
+	err = json.Unmarshal(resp, &ir)
+	if err != nil {
+		return []Integration{}, err
+	}
+
+	return ir.Data, nil
+}
+
+// GetCorpIntegration gets a corp integration by id.
+func (sc *Client) GetCorpIntegration(corpName, id string) (Integration, error) {
+	return sc.GetCorpIntegrationContext(context.Background(), corpName, id)
+}
+
+// GetCorpIntegrationContext is like GetCorpIntegration but uses the given context.
+func (sc *Client) GetCorpIntegrationContext(ctx context.Context, corpName, id string) (Integration, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/integrations/%s", corpName, id), "")
+	if err != nil {
+		return Integration{}, err
+	}
+
+	var i Integration
+	err = json.Unmarshal(resp, &i)
+	if err != nil {
+		return Integration{}, err
+	}
+
+	return i, nil
+}
+
+// UpdateCorpIntegration updates a corp integration by id. The events are
+// not validated; see AddCorpIntegration.
+func (sc *Client) UpdateCorpIntegration(corpName, id string, body UpdateIntegrationBody) error {
+	return sc.UpdateCorpIntegrationContext(context.Background(), corpName, id, body)
+}
+
+// UpdateCorpIntegrationContext is like UpdateCorpIntegration but uses the given context.
+func (sc *Client) UpdateCorpIntegrationContext(ctx context.Context, corpName, id string, body UpdateIntegrationBody) error {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	_, err = sc.doRequest(ctx, "PATCH", fmt.Sprintf("/v0/corps/%s/integrations/%s", corpName, id), string(b))
+	return err
+}
+
+// DeleteCorpIntegration deletes a corp integration by id.
+func (sc *Client) DeleteCorpIntegration(corpName, id string) error {
+	return sc.DeleteCorpIntegrationContext(context.Background(), corpName, id)
+}
+
+// DeleteCorpIntegrationContext is like DeleteCorpIntegration but uses the given context.
+func (sc *Client) DeleteCorpIntegrationContext(ctx context.Context, corpName, id string) error {
+	_, err := sc.doRequest(ctx, "DELETE", fmt.Sprintf("/v0/corps/%s/integrations/%s", corpName, id), "")
+
+	return err
+}
@@ -0,0 +1,79 @@
+package sigsci
+
+import (
+	"testing"
+)
+
+func TestIntegrationBodyValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    IntegrationBody
+		siteErr bool
+		corpErr bool
+	}{
+		{"site events", IntegrationBody{URL: "https://hooks.slack.com/x", Type: IntegrationTypeSlack, Events: []IntegrationEvent{EventFlag, EventAgentAlert}}, false, true},
+		{"corp events", IntegrationBody{URL: "https://example.com/hook", Type: IntegrationTypeGeneric, Events: []IntegrationEvent{EventNewSite}}, true, false},
+		{"shared events", IntegrationBody{URL: "https://example.com/hook", Type: IntegrationTypeGeneric, Events: []IntegrationEvent{EventRuleCreated}}, false, false},
+		{"unknown type", IntegrationBody{URL: "https://example.com/hook", Type: "carrierPigeon", Events: []IntegrationEvent{EventFlag}}, true, true},
+		{"unknown event", IntegrationBody{URL: "https://example.com/hook", Type: IntegrationTypeGeneric, Events: []IntegrationEvent{"everything"}}, true, true},
+		{"no events", IntegrationBody{URL: "https://example.com/hook", Type: IntegrationTypeGeneric}, true, true},
+		{"no url", IntegrationBody{Type: IntegrationTypeGeneric, Events: []IntegrationEvent{EventFlag}}, true, true},
+	}
+
+	for _, tt := range tests {
+		if err := tt.body.Validate(); (err != nil) != tt.siteErr {
+			t.Errorf("%s: Validate() = %v, want error %v", tt.name, err, tt.siteErr)
+		}
+		if err := tt.body.ValidateCorp(); (err != nil) != tt.corpErr {
+			t.Errorf("%s: ValidateCorp() = %v, want error %v", tt.name, err, tt.corpErr)
+		}
+	}
+}
+
+func TestIntegrationsNotValidated(t *testing.T) {
+	integration := Integration{ID: "i1", Type: IntegrationTypeGeneric, URL: "https://hooks.example.com", Events: []IntegrationEvent{"newEvent"}}
+	response := `{"data":[{"id":"i1","type":"generic","url":"https://hooks.example.com","events":["newEvent"]}]}`
+
+	runRequestTests(t, []requestTest{
+		{
+			name: "add site",
+			call: func(sc *Client) (interface{}, error) {
+				return sc.AddIntegration("testcorp", "www.mysite.com", IntegrationBody{Type: IntegrationTypeGeneric, URL: "https://hooks.example.com", Events: []IntegrationEvent{"newEvent"}})
+			},
+			method:   "POST",
+			path:     "/v0/corps/testcorp/sites/www.mysite.com/integrations",
+			body:     `{"url":"https://hooks.example.com","type":"generic","events":["newEvent"]}`,
+			response: response,
+			want:     []Integration{integration},
+		},
+		{
+			name: "update site",
+			call: func(sc *Client) (interface{}, error) {
+				return nil, sc.UpdateIntegration("testcorp", "www.mysite.com", "i1", UpdateIntegrationBody{Events: []IntegrationEvent{"newEvent"}})
+			},
+			method: "PATCH",
+			path:   "/v0/corps/testcorp/sites/www.mysite.com/integrations/i1",
+			body:   `{"events":["newEvent"]}`,
+		},
+		{
+			name: "add corp",
+			call: func(sc *Client) (interface{}, error) {
+				return sc.AddCorpIntegration("testcorp", IntegrationBody{Type: IntegrationTypeGeneric, URL: "https://hooks.example.com", Events: []IntegrationEvent{"newEvent"}})
+			},
+			method:   "POST",
+			path:     "/v0/corps/testcorp/integrations",
+			body:     `{"url":"https://hooks.example.com","type":"generic","events":["newEvent"]}`,
+			response: response,
+			want:     []Integration{integration},
+		},
+		{
+			name: "update corp",
+			call: func(sc *Client) (interface{}, error) {
+				return nil, sc.UpdateCorpIntegration("testcorp", "i1", UpdateIntegrationBody{Events: []IntegrationEvent{"newEvent"}})
+			},
+			method: "PATCH",
+			path:   "/v0/corps/testcorp/integrations/i1",
+			body:   `{"events":["newEvent"]}`,
+		},
+	})
+}
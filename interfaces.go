@@ -0,0 +1,376 @@
+package sigsci
+
+import (
+	"context"
+	"net/url"
+)
+
+// The interfaces below group the methods of Client by domain, so code
+// that uses the API can depend on just the part it needs and be tested
+// with a fake such as sigscimock.Client. Every method has a Context
+// variant, as on Client.
+//
+// The iterator constructors, such as SearchRequestsIterator, are not part
+// of the interfaces, as the iterators they return are tied to a Client.
+
+// AuthAPI is the part of the API for ending the session of a client.
+type AuthAPI interface {
+	Logout() error
+	LogoutContext(ctx context.Context) error
+}
+
+// CorpAPI is the part of the API for reading and updating corps and
+// their activity.
+type CorpAPI interface {
+	ListCorps() ([]Corp, error)
+	ListCorpsContext(ctx context.Context) ([]Corp, error)
+	GetCorp(corpName string) (Corp, error)
+	GetCorpContext(ctx context.Context, corpName string) (Corp, error)
+	UpdateCorp(corpName string, body UpdateCorpBody) (Corp, error)
+	UpdateCorpContext(ctx context.Context, corpName string, body UpdateCorpBody) (Corp, error)
+	ListCorpActivity(corpName string, limit, page int) ([]ActivityEvent, error)
+	ListCorpActivityContext(ctx context.Context, corpName string, limit, page int) ([]ActivityEvent, error)
+}
+
+// UserAPI is the part of the API for managing corp users and site members.
+type UserAPI interface {
+	ListCorpUsers(corpName string) ([]CorpUser, error)
+	ListCorpUsersContext(ctx context.Context, corpName string) ([]CorpUser, error)
+	GetCorpUser(corpName, email string) (CorpUser, error)
+	GetCorpUserContext(ctx context.Context, corpName, email string) (CorpUser, error)
+	InviteUser(corpName, email string, invite CorpUserInvite) (CorpUser, error)
+	InviteUserContext(ctx context.Context, corpName, email string, invite CorpUserInvite) (CorpUser, error)
+	UpdateCorpUser(corpName, email string, body CorpUserInvite) (CorpUser, error)
+	UpdateCorpUserContext(ctx context.Context, corpName, email string, body CorpUserInvite) (CorpUser, error)
+	DeleteCorpUser(corpName, email string) error
+	DeleteCorpUserContext(ctx context.Context, corpName, email string) error
+	ListSiteMembers(corpName, siteName string) ([]SiteMember, error)
+	ListSiteMembersContext(ctx context.Context, corpName, siteName string) ([]SiteMember, error)
+	GetSiteMember(corpName, siteName, email string) (SiteMember, error)
+	GetSiteMemberContext(ctx context.Context, corpName, siteName, email string) (SiteMember, error)
+	AddSiteMember(corpName, siteName, email string) (SiteMemberResponse, error)
+	AddSiteMemberContext(ctx context.Context, corpName, siteName, email string) (SiteMemberResponse, error)
+	AddSiteMembers(corpName, siteName string, body SiteMembersBody) ([]SiteMember, error)
+	AddSiteMembersContext(ctx context.Context, corpName, siteName string, body SiteMembersBody) ([]SiteMember, error)
+	InviteSiteMember(corpName, siteName, email string, body SiteMemberBody) (SiteMemberResponse, error)
+	InviteSiteMemberContext(ctx context.Context, corpName, siteName, email string, body SiteMemberBody) (SiteMemberResponse, error)
+	SetSiteMemberRole(corpName, siteName, email string, role Role) (SiteMemberResponse, error)
+	SetSiteMemberRoleContext(ctx context.Context, corpName, siteName, email string, role Role) (SiteMemberResponse, error)
+	DeleteSiteMember(corpName, siteName, email string) error
+	DeleteSiteMemberContext(ctx context.Context, corpName, siteName, email string) error
+}
+
+// TokenAPI is the part of the API for managing API access tokens.
+type TokenAPI interface {
+	ListAPITokens() ([]APIToken, error)
+	ListAPITokensContext(ctx context.Context) ([]APIToken, error)
+	CreateAPIToken(body CreateAPITokenBody) (APIToken, error)
+	CreateAPITokenContext(ctx context.Context, body CreateAPITokenBody) (APIToken, error)
+	GetAPIToken(id string) (APIToken, error)
+	GetAPITokenContext(ctx context.Context, id string) (APIToken, error)
+	DeleteAPIToken(id string) error
+	DeleteAPITokenContext(ctx context.Context, id string) error
+	ListCorpUserAPITokens(corpName, email string) ([]APIToken, error)
+	ListCorpUserAPITokensContext(ctx context.Context, corpName, email string) ([]APIToken, error)
+	DeleteCorpUserAPIToken(corpName, email, id string) error
+	DeleteCorpUserAPITokenContext(ctx context.Context, corpName, email, id string) error
+	RotateToken(email, name, oldID string) (APIToken, error)
+	RotateTokenContext(ctx context.Context, email, name, oldID string) (APIToken, error)
+}
+
+// SiteAPI is the part of the API for managing sites and their settings:
+// monitors, header links and redactions.
+type SiteAPI interface {
+	ListSites(corpName string) ([]Site, error)
+	ListSitesContext(ctx context.Context, corpName string) ([]Site, error)
+	GetSite(corpName, siteName string) (Site, error)
+	GetSiteContext(ctx context.Context, corpName, siteName string) (Site, error)
+	CreateSite(corpName string, body CreateSiteBody) (Site, error)
+	CreateSiteContext(ctx context.Context, corpName string, body CreateSiteBody) (Site, error)
+	UpdateSite(corpName, siteName string, body UpdateSiteBody) (Site, error)
+	UpdateSiteContext(ctx context.Context, corpName, siteName string, body UpdateSiteBody) (Site, error)
+	DeleteSite(corpName, siteName string) error
+	DeleteSiteContext(ctx context.Context, corpName, siteName string) error
+	ListSiteActivity(corpName, siteName string, limit, page int) ([]ActivityEvent, error)
+	ListSiteActivityContext(ctx context.Context, corpName, siteName string, limit, page int) ([]ActivityEvent, error)
+	GetSiteMonitor(corpName, siteName, email string) (SiteMonitor, error)
+	GetSiteMonitorContext(ctx context.Context, corpName, siteName, email string) (SiteMonitor, error)
+	GenerateSiteMonitor(corpName, siteName string) (SiteMonitor, error)
+	GenerateSiteMonitorContext(ctx context.Context, corpName, siteName string) (SiteMonitor, error)
+	EnableSiteMonitor(corpName, siteName string) error
+	EnableSiteMonitorContext(ctx context.Context, corpName, siteName string) error
+	DisableSiteMonitor(corpName, siteName string) error
+	DisableSiteMonitorContext(ctx context.Context, corpName, siteName string) error
+	ListHeaderLinks(corpName, siteName string) ([]HeaderLink, error)
+	ListHeaderLinksContext(ctx context.Context, corpName, siteName string) ([]HeaderLink, error)
+	GetHeaderLink(corpName, siteName, id string) (HeaderLink, error)
+	GetHeaderLinkContext(ctx context.Context, corpName, siteName, id string) (HeaderLink, error)
+	AddHeaderLink(corpName, siteName string, body HeaderLinkBody) ([]HeaderLink, error)
+	AddHeaderLinkContext(ctx context.Context, corpName, siteName string, body HeaderLinkBody) ([]HeaderLink, error)
+	UpdateHeaderLink(corpName, siteName, id string, body HeaderLinkBody) (HeaderLink, error)
+	UpdateHeaderLinkContext(ctx context.Context, corpName, siteName, id string, body HeaderLinkBody) (HeaderLink, error)
+	DeleteHeaderLink(corpName, siteName, id string) error
+	DeleteHeaderLinkContext(ctx context.Context, corpName, siteName, id string) error
+	ListRedactions(corpName, siteName string) ([]Redaction, error)
+	ListRedactionsContext(ctx context.Context, corpName, siteName string) ([]Redaction, error)
+	GetRedaction(corpName, siteName, id string) (Redaction, error)
+	GetRedactionContext(ctx context.Context, corpName, siteName, id string) (Redaction, error)
+	AddRedaction(corpName, siteName string, body RedactionBody) ([]Redaction, error)
+	AddRedactionContext(ctx context.Context, corpName, siteName string, body RedactionBody) ([]Redaction, error)
+	UpdateRedaction(corpName, siteName, id string, body UpdateRedactionBody) (Redaction, error)
+	UpdateRedactionContext(ctx context.Context, corpName, siteName, id string, body UpdateRedactionBody) (Redaction, error)
+	DeleteRedaction(corpName, siteName, id string) error
+	DeleteRedactionContext(ctx context.Context, corpName, siteName, id string) error
+}
+
+// AgentAPI is the part of the API for reading agents and their logs.
+type AgentAPI interface {
+	ListAgents(corpName, siteName string) ([]Agent, error)
+	ListAgentsContext(ctx context.Context, corpName, siteName string) ([]Agent, error)
+	GetAgent(corpName, siteName, agentName string) (Agent, error)
+	GetAgentContext(ctx context.Context, corpName, siteName, agentName string) (Agent, error)
+	GetAgentLogs(corpName, siteName, agentName string) ([]AgentLog, error)
+	GetAgentLogsContext(ctx context.Context, corpName, siteName, agentName string) ([]AgentLog, error)
+}
+
+// ReportAPI is the part of the API for reading reports and timeseries.
+type ReportAPI interface {
+	GetOverviewReport(corpName string, query url.Values) ([]OverviewSite, error)
+	GetOverviewReportContext(ctx context.Context, corpName string, query url.Values) ([]OverviewSite, error)
+	ListTopAttacks(corpName, siteName string, query url.Values) ([]TopAttack, error)
+	ListTopAttacksContext(ctx context.Context, corpName, siteName string, query url.Values) ([]TopAttack, error)
+	GetTimeseries(corpName, siteName string, query url.Values) ([]Timeseries, error)
+	GetTimeseriesContext(ctx context.Context, corpName, siteName string, query url.Values) ([]Timeseries, error)
+}
+
+// EventAPI is the part of the API for reading and expiring events and
+// suspicious IPs.
+type EventAPI interface {
+	ListEvents(corpName, siteName string, query url.Values) ([]Event, error)
+	ListEventsContext(ctx context.Context, corpName, siteName string, query url.Values) ([]Event, error)
+	GetEvent(corpName, siteName, id string) (Event, error)
+	GetEventContext(ctx context.Context, corpName, siteName, id string) (Event, error)
+	ExpireEvent(corpName, siteName, id string) (Event, error)
+	ExpireEventContext(ctx context.Context, corpName, siteName, id string) (Event, error)
+	ListSuspiciousIPs(corpName, siteName string) ([]SuspiciousIP, error)
+	ListSuspiciousIPsContext(ctx context.Context, corpName, siteName string) ([]SuspiciousIP, error)
+}
+
+// RequestAPI is the part of the API for searching requests and reading
+// the request feed.
+type RequestAPI interface {
+	SearchRequests(corpName, siteName string, query url.Values) (next string, requests []Request, err error)
+	SearchRequestsContext(ctx context.Context, corpName, siteName string, query url.Values) (next string, requests []Request, err error)
+	GetRequest(corpName, siteName, id string) (Request, error)
+	GetRequestContext(ctx context.Context, corpName, siteName, id string) (Request, error)
+	GetRequestFeed(corpName, siteName string, query url.Values) (next string, requests []Request, err error)
+	GetRequestFeedContext(ctx context.Context, corpName, siteName string, query url.Values) (next string, requests []Request, err error)
+}
+
+// IPListAPI is the part of the API for managing whitelisted and
+// blacklisted IPs.
+type IPListAPI interface {
+	ListWhitelistIPs(corpName, siteName string) ([]ListIP, error)
+	ListWhitelistIPsContext(ctx context.Context, corpName, siteName string) ([]ListIP, error)
+	AddWhitelistIP(corpName, siteName string, body ListIPBody) (ListIP, error)
+	AddWhitelistIPContext(ctx context.Context, corpName, siteName string, body ListIPBody) (ListIP, error)
+	DeleteWhitelistIP(corpName, siteName, id string) error
+	DeleteWhitelistIPContext(ctx context.Context, corpName, siteName, id string) error
+	ListBlacklistIPs(corpName, siteName string) ([]ListIP, error)
+	ListBlacklistIPsContext(ctx context.Context, corpName, siteName string) ([]ListIP, error)
+	AddBlacklistIP(corpName, siteName string, body ListIPBody) (ListIP, error)
+	AddBlacklistIPContext(ctx context.Context, corpName, siteName string, body ListIPBody) (ListIP, error)
+	DeleteBlacklistIP(corpName, siteName, id string) error
+	DeleteBlacklistIPContext(ctx context.Context, corpName, siteName, id string) error
+}
+
+// ParamPathAPI is the part of the API for managing whitelisted parameters
+// and paths.
+type ParamPathAPI interface {
+	ListParams(corpName, siteName string) ([]Param, error)
+	ListParamsContext(ctx context.Context, corpName, siteName string) ([]Param, error)
+	GetParam(corpName, siteName, id string) (Param, error)
+	GetParamContext(ctx context.Context, corpName, siteName, id string) (Param, error)
+	AddParam(corpName, siteName string, body ParamBody) (Param, error)
+	AddParamContext(ctx context.Context, corpName, siteName string, body ParamBody) (Param, error)
+	DeleteParam(corpName, siteName, id string) error
+	DeleteParamContext(ctx context.Context, corpName, siteName, id string) error
+	ListPaths(corpName, siteName string) ([]Path, error)
+	ListPathsContext(ctx context.Context, corpName, siteName string) ([]Path, error)
+	GetPath(corpName, siteName, id string) (Path, error)
+	GetPathContext(ctx context.Context, corpName, siteName, id string) (Path, error)
+	AddPath(corpName, siteName string, body PathBody) (Path, error)
+	AddPathContext(ctx context.Context, corpName, siteName string, body PathBody) (Path, error)
+	DeletePath(corpName, siteName, id string) error
+	DeletePathContext(ctx context.Context, corpName, siteName, id string) error
+}
+
+// AlertAPI is the part of the API for managing custom alerts.
+type AlertAPI interface {
+	ListCustomAlerts(corpName, siteName string) ([]CustomAlert, error)
+	ListCustomAlertsContext(ctx context.Context, corpName, siteName string) ([]CustomAlert, error)
+	GetCustomAlert(corpName, siteName, id string) (CustomAlert, error)
+	GetCustomAlertContext(ctx context.Context, corpName, siteName, id string) (CustomAlert, error)
+	CreateCustomAlert(corpName, siteName string, body CustomAlertBody) (CustomAlert, error)
+	CreateCustomAlertContext(ctx context.Context, corpName, siteName string, body CustomAlertBody) (CustomAlert, error)
+	UpdateCustomAlert(corpName, siteName, id string, body CustomAlertBody) (CustomAlert, error)
+	UpdateCustomAlertContext(ctx context.Context, corpName, siteName, id string, body CustomAlertBody) (CustomAlert, error)
+	DeleteCustomAlert(corpName, siteName, id string) error
+	DeleteCustomAlertContext(ctx context.Context, corpName, siteName, id string) error
+}
+
+// IntegrationAPI is the part of the API for managing site and corp
+// integrations.
+type IntegrationAPI interface {
+	ListIntegrations(corpName, siteName string) ([]Integration, error)
+	ListIntegrationsContext(ctx context.Context, corpName, siteName string) ([]Integration, error)
+	GetIntegration(corpName, siteName, id string) (Integration, error)
+	GetIntegrationContext(ctx context.Context, corpName, siteName, id string) (Integration, error)
+	AddIntegration(corpName, siteName string, body IntegrationBody) ([]Integration, error)
+	AddIntegrationContext(ctx context.Context, corpName, siteName string, body IntegrationBody) ([]Integration, error)
+	UpdateIntegration(corpName, siteName, id string, body UpdateIntegrationBody) error
+	UpdateIntegrationContext(ctx context.Context, corpName, siteName, id string, body UpdateIntegrationBody) error
+	DeleteIntegration(corpName, siteName, id string) error
+	DeleteIntegrationContext(ctx context.Context, corpName, siteName, id string) error
+	ListCorpIntegrations(corpName string) ([]Integration, error)
+	ListCorpIntegrationsContext(ctx context.Context, corpName string) ([]Integration, error)
+	GetCorpIntegration(corpName, id string) (Integration, error)
+	GetCorpIntegrationContext(ctx context.Context, corpName, id string) (Integration, error)
+	AddCorpIntegration(corpName string, body IntegrationBody) ([]Integration, error)
+	AddCorpIntegrationContext(ctx context.Context, corpName string, body IntegrationBody) ([]Integration, error)
+	UpdateCorpIntegration(corpName, id string, body UpdateIntegrationBody) error
+	UpdateCorpIntegrationContext(ctx context.Context, corpName, id string, body UpdateIntegrationBody) error
+	DeleteCorpIntegration(corpName, id string) error
+	DeleteCorpIntegrationContext(ctx context.Context, corpName, id string) error
+}
+
+// RuleAPI is the part of the API for managing site, corp and templated
+// rules.
+type RuleAPI interface {
+	ListRules(corpName, siteName string) ([]Rule, error)
+	ListRulesContext(ctx context.Context, corpName, siteName string) ([]Rule, error)
+	GetRule(corpName, siteName, id string) (Rule, error)
+	GetRuleContext(ctx context.Context, corpName, siteName, id string) (Rule, error)
+	CreateRule(corpName, siteName string, body RuleBody) (Rule, error)
+	CreateRuleContext(ctx context.Context, corpName, siteName string, body RuleBody) (Rule, error)
+	UpdateRule(corpName, siteName, id string, body RuleBody) (Rule, error)
+	UpdateRuleContext(ctx context.Context, corpName, siteName, id string, body RuleBody) (Rule, error)
+	DeleteRule(corpName, siteName, id string) error
+	DeleteRuleContext(ctx context.Context, corpName, siteName, id string) error
+	ListRateLimitRules(corpName, siteName string) ([]Rule, error)
+	ListRateLimitRulesContext(ctx context.Context, corpName, siteName string) ([]Rule, error)
+	ListCorpRules(corpName string) ([]CorpRule, error)
+	ListCorpRulesContext(ctx context.Context, corpName string) ([]CorpRule, error)
+	GetCorpRule(corpName, id string) (CorpRule, error)
+	GetCorpRuleContext(ctx context.Context, corpName, id string) (CorpRule, error)
+	CreateCorpRule(corpName string, body CorpRuleBody) (CorpRule, error)
+	CreateCorpRuleContext(ctx context.Context, corpName string, body CorpRuleBody) (CorpRule, error)
+	UpdateCorpRule(corpName, id string, body CorpRuleBody) (CorpRule, error)
+	UpdateCorpRuleContext(ctx context.Context, corpName, id string, body CorpRuleBody) (CorpRule, error)
+	DeleteCorpRule(corpName, id string) error
+	DeleteCorpRuleContext(ctx context.Context, corpName, id string) error
+	ListTemplatedRules(corpName, siteName string) ([]TemplatedRule, error)
+	ListTemplatedRulesContext(ctx context.Context, corpName, siteName string) ([]TemplatedRule, error)
+	GetTemplatedRule(corpName, siteName, name string) (TemplatedRule, error)
+	GetTemplatedRuleContext(ctx context.Context, corpName, siteName, name string) (TemplatedRule, error)
+	UpdateTemplatedRule(corpName, siteName, name string, body UpdateTemplatedRuleBody) (TemplatedRule, error)
+	UpdateTemplatedRuleContext(ctx context.Context, corpName, siteName, name string, body UpdateTemplatedRuleBody) (TemplatedRule, error)
+}
+
+// SignalAPI is the part of the API for managing site and corp signals.
+type SignalAPI interface {
+	ListSiteSignals(corpName, siteName string) ([]Signal, error)
+	ListSiteSignalsContext(ctx context.Context, corpName, siteName string) ([]Signal, error)
+	GetSiteSignal(corpName, siteName, tagName string) (Signal, error)
+	GetSiteSignalContext(ctx context.Context, corpName, siteName, tagName string) (Signal, error)
+	CreateSiteSignal(corpName, siteName string, body CreateSignalBody) (Signal, error)
+	CreateSiteSignalContext(ctx context.Context, corpName, siteName string, body CreateSignalBody) (Signal, error)
+	UpdateSiteSignal(corpName, siteName, tagName string, body UpdateSignalBody) (Signal, error)
+	UpdateSiteSignalContext(ctx context.Context, corpName, siteName, tagName string, body UpdateSignalBody) (Signal, error)
+	DeleteSiteSignal(corpName, siteName, tagName string) error
+	DeleteSiteSignalContext(ctx context.Context, corpName, siteName, tagName string) error
+	ListCorpSignals(corpName string) ([]Signal, error)
+	ListCorpSignalsContext(ctx context.Context, corpName string) ([]Signal, error)
+	GetCorpSignal(corpName, tagName string) (Signal, error)
+	GetCorpSignalContext(ctx context.Context, corpName, tagName string) (Signal, error)
+	CreateCorpSignal(corpName string, body CreateSignalBody) (Signal, error)
+	CreateCorpSignalContext(ctx context.Context, corpName string, body CreateSignalBody) (Signal, error)
+	UpdateCorpSignal(corpName, tagName string, body UpdateSignalBody) (Signal, error)
+	UpdateCorpSignalContext(ctx context.Context, corpName, tagName string, body UpdateSignalBody) (Signal, error)
+	DeleteCorpSignal(corpName, tagName string) error
+	DeleteCorpSignalContext(ctx context.Context, corpName, tagName string) error
+}
+
+// ListAPI is the part of the API for managing site and corp lists.
+type ListAPI interface {
+	ListSiteLists(corpName, siteName string) ([]List, error)
+	ListSiteListsContext(ctx context.Context, corpName, siteName string) ([]List, error)
+	GetSiteList(corpName, siteName, id string) (List, error)
+	GetSiteListContext(ctx context.Context, corpName, siteName, id string) (List, error)
+	CreateSiteList(corpName, siteName string, body CreateListBody) (List, error)
+	CreateSiteListContext(ctx context.Context, corpName, siteName string, body CreateListBody) (List, error)
+	UpdateSiteList(corpName, siteName, id string, body UpdateListBody) (List, error)
+	UpdateSiteListContext(ctx context.Context, corpName, siteName, id string, body UpdateListBody) (List, error)
+	DeleteSiteList(corpName, siteName, id string) error
+	DeleteSiteListContext(ctx context.Context, corpName, siteName, id string) error
+	AddSiteListEntries(corpName, siteName, id string, entries []string) (List, error)
+	AddSiteListEntriesContext(ctx context.Context, corpName, siteName, id string, entries []string) (List, error)
+	RemoveSiteListEntries(corpName, siteName, id string, entries []string) (List, error)
+	RemoveSiteListEntriesContext(ctx context.Context, corpName, siteName, id string, entries []string) (List, error)
+	ListCorpLists(corpName string) ([]List, error)
+	ListCorpListsContext(ctx context.Context, corpName string) ([]List, error)
+	GetCorpList(corpName, id string) (List, error)
+	GetCorpListContext(ctx context.Context, corpName, id string) (List, error)
+	CreateCorpList(corpName string, body CreateListBody) (List, error)
+	CreateCorpListContext(ctx context.Context, corpName string, body CreateListBody) (List, error)
+	UpdateCorpList(corpName, id string, body UpdateListBody) (List, error)
+	UpdateCorpListContext(ctx context.Context, corpName, id string, body UpdateListBody) (List, error)
+	DeleteCorpList(corpName, id string) error
+	DeleteCorpListContext(ctx context.Context, corpName, id string) error
+	AddCorpListEntries(corpName, id string, entries []string) (List, error)
+	AddCorpListEntriesContext(ctx context.Context, corpName, id string, entries []string) (List, error)
+	RemoveCorpListEntries(corpName, id string, entries []string) (List, error)
+	RemoveCorpListEntriesContext(ctx context.Context, corpName, id string, entries []string) (List, error)
+}
+
+// API is the complete API implemented by Client.
+type API interface {
+	AuthAPI
+	CorpAPI
+	UserAPI
+	TokenAPI
+	SiteAPI
+	AgentAPI
+	ReportAPI
+	EventAPI
+	RequestAPI
+	IPListAPI
+	ParamPathAPI
+	AlertAPI
+	IntegrationAPI
+	RuleAPI
+	SignalAPI
+	ListAPI
+}
+
+// Client implements every interface.
+var (
+	_ API = (*Client)(nil)
+
+	_ AuthAPI        = (*Client)(nil)
+	_ CorpAPI        = (*Client)(nil)
+	_ UserAPI        = (*Client)(nil)
+	_ TokenAPI       = (*Client)(nil)
+	_ SiteAPI        = (*Client)(nil)
+	_ AgentAPI       = (*Client)(nil)
+	_ ReportAPI      = (*Client)(nil)
+	_ EventAPI       = (*Client)(nil)
+	_ RequestAPI     = (*Client)(nil)
+	_ IPListAPI      = (*Client)(nil)
+	_ ParamPathAPI   = (*Client)(nil)
+	_ AlertAPI       = (*Client)(nil)
+	_ IntegrationAPI = (*Client)(nil)
+	_ RuleAPI        = (*Client)(nil)
+	_ SignalAPI      = (*Client)(nil)
+	_ ListAPI        = (*Client)(nil)
+)
@@ -0,0 +1,398 @@
+package sigsci
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// pager fetches successive pages of a paginated endpoint by following the
+// next URIs returned by the API.
+type pager struct {
+	sc    *Client
+	ctx   context.Context
+	path  string
+	total int
+	err   error
+}
+
+// fetch decodes the next page into v. It returns false when there are no
+// more pages or an error occurred.
+func (p *pager) fetch(v interface{}) bool {
+	if p.err != nil || p.path == "" {
+		return false
+	}
+
+	if err := p.ctx.Err(); err != nil {
+		p.err = err
+		return false
+	}
+
+	resp, err := p.sc.doRequest(p.ctx, "GET", p.path, "")
+	if err != nil {
+		p.err = err
+		return false
+	}
+
+	var pr struct {
+		TotalCount int
+		Next       map[string]string
+	}
+	err = json.Unmarshal(resp, &pr)
+	if err != nil {
+		p.err = err
+		return false
+	}
+
+	err = json.Unmarshal(resp, v)
+	if err != nil {
+		p.err = err
+		return false
+	}
+
+	p.total = pr.TotalCount
+	p.path = nextPath(pr.Next["uri"])
+
+	return true
+}
+
+// nextPath converts a next URI returned by the API, which includes the
+// /api prefix, to a path relative to the API base URL.
+func nextPath(uri string) string {
+	if uri == "" {
+		return ""
+	}
+
+	if u, err := url.Parse(uri); err == nil && u.IsAbs() {
+		uri = u.RequestURI()
+	}
+
+	return strings.TrimPrefix(uri, "/api")
+}
+
+// withQuery appends the encoded query to path.
+func withQuery(path string, query url.Values) string {
+	if query.Encode() != "" {
+		path += "?" + query.Encode()
+	}
+
+	return path
+}
+
+// RequestIterator iterates over requests across pages. Call Next to advance
+// the iterator and Request to get the current request. When Next returns
+// false, check Err.
+type RequestIterator struct {
+	p    pager
+	page []Request
+	cur  Request
+}
+
+// SearchRequestsIterator returns an iterator over all requests matching
+// the search query, following next URIs across pages.
+func (sc *Client) SearchRequestsIterator(corpName, siteName string, query url.Values) *RequestIterator {
+	return sc.SearchRequestsIteratorContext(context.Background(), corpName, siteName, query)
+}
+
+// SearchRequestsIteratorContext is like SearchRequestsIterator but uses the given context.
+func (sc *Client) SearchRequestsIteratorContext(ctx context.Context, corpName, siteName string, query url.Values) *RequestIterator {
+	return &RequestIterator{
+		p: pager{
+			sc:   sc,
+			ctx:  ctx,
+			path: withQuery(fmt.Sprintf("/v0/corps/%s/sites/%s/requests", corpName, siteName), query),
+		},
+	}
+}
+
+// RequestFeedIterator returns an iterator over the request feed for the
+// site, following next URIs across pages.
+func (sc *Client) RequestFeedIterator(corpName, siteName string, query url.Values) *RequestIterator {
+	return sc.RequestFeedIteratorContext(context.Background(), corpName, siteName, query)
+}
+
+// RequestFeedIteratorContext is like RequestFeedIterator but uses the given context.
+func (sc *Client) RequestFeedIteratorContext(ctx context.Context, corpName, siteName string, query url.Values) *RequestIterator {
+	return &RequestIterator{
+		p: pager{
+			sc:   sc,
+			ctx:  ctx,
+			path: withQuery(fmt.Sprintf("/v0/corps/%s/sites/%s/feed/requests", corpName, siteName), query),
+		},
+	}
+}
+
+// Next advances the iterator to the next request, fetching the next page
+// if needed. It returns false when there are no more requests, the
+// context is done or an error occurred.
+func (it *RequestIterator) Next() bool {
+	for len(it.page) == 0 {
+		var r requestsResponse
+		if !it.p.fetch(&r) {
+			return false
+		}
+		it.page = r.Data
+	}
+
+	it.cur, it.page = it.page[0], it.page[1:]
+
+	return true
+}
+
+// Request returns the current request.
+func (it *RequestIterator) Request() Request {
+	return it.cur
+}
+
+// TotalCount returns the total number of matching requests reported by
+// the last page fetched. The request feed does not report a total count.
+func (it *RequestIterator) TotalCount() int {
+	return it.p.total
+}
+
+// Err returns the error, if any, that stopped the iteration.
+func (it *RequestIterator) Err() error {
+	return it.p.err
+}
+
+// EventIterator iterates over events across pages. Call Next to advance
+// the iterator and Event to get the current event. When Next returns
+// false, check Err.
+type EventIterator struct {
+	p    pager
+	page []Event
+	cur  Event
+}
+
+// ListEventsIterator returns an iterator over all events for a given site,
+// following next URIs across pages.
+func (sc *Client) ListEventsIterator(corpName, siteName string, query url.Values) *EventIterator {
+	return sc.ListEventsIteratorContext(context.Background(), corpName, siteName, query)
+}
+
+// ListEventsIteratorContext is like ListEventsIterator but uses the given context.
+func (sc *Client) ListEventsIteratorContext(ctx context.Context, corpName, siteName string, query url.Values) *EventIterator {
+	return &EventIterator{
+		p: pager{
+			sc:   sc,
+			ctx:  ctx,
+			path: withQuery(fmt.Sprintf("/v0/corps/%s/sites/%s/events", corpName, siteName), query),
+		},
+	}
+}
+
+// Next advances the iterator to the next event, fetching the next page if
+// needed. It returns false when there are no more events, the context is
+// done or an error occurred.
+func (it *EventIterator) Next() bool {
+	for len(it.page) == 0 {
+		var er eventsResponse
+		if !it.p.fetch(&er) {
+			return false
+		}
+		it.page = er.Data
+	}
+
+	it.cur, it.page = it.page[0], it.page[1:]
+
+	return true
+}
+
+// Event returns the current event.
+func (it *EventIterator) Event() Event {
+	return it.cur
+}
+
+// TotalCount returns the total number of events reported by the last page
+// fetched.
+func (it *EventIterator) TotalCount() int {
+	return it.p.total
+}
+
+// Err returns the error, if any, that stopped the iteration.
+func (it *EventIterator) Err() error {
+	return it.p.err
+}
+
+// ActivityQuery selects the activity events returned by an
+// ActivityIterator.
+type ActivityQuery struct {
+	// Limit is the number of events per page. It defaults to 100.
+	Limit int
+	// Page is the first page to fetch, starting at 1. Set it to the value
+	// of ActivityIterator.Page to resume an earlier iteration.
+	Page int
+	// From and Until, if not zero, restrict the events to a time range.
+	From  time.Time
+	Until time.Time
+}
+
+// values returns the query parameters for the given page.
+func (q ActivityQuery) values(page int) url.Values {
+	v := url.Values{}
+	v.Set("limit", strconv.Itoa(q.Limit))
+	v.Set("page", strconv.Itoa(page))
+	if !q.From.IsZero() {
+		v.Set("from", strconv.FormatInt(q.From.Unix(), 10))
+	}
+	if !q.Until.IsZero() {
+		v.Set("until", strconv.FormatInt(q.Until.Unix(), 10))
+	}
+
+	return v
+}
+
+// ActivityIterator iterates over activity events across pages. Call Next
+// to advance the iterator and Event to get the current event. When Next
+// returns false, check Err.
+type ActivityIterator struct {
+	sc    *Client
+	ctx   context.Context
+	path  string
+	query ActivityQuery
+	next  int
+	uri   string // next URI returned by the API, if any
+	count int    // events fetched, when starting at the first page
+	done  bool
+	total int
+	err   error
+
+	page    []ActivityEvent
+	cur     ActivityEvent
+	curPage int
+}
+
+// CorpActivityIterator returns an iterator over the activity events for
+// a given corp.
+func (sc *Client) CorpActivityIterator(corpName string, query ActivityQuery) *ActivityIterator {
+	return sc.CorpActivityIteratorContext(context.Background(), corpName, query)
+}
+
+// CorpActivityIteratorContext is like CorpActivityIterator but uses the given context.
+func (sc *Client) CorpActivityIteratorContext(ctx context.Context, corpName string, query ActivityQuery) *ActivityIterator {
+	return newActivityIterator(ctx, sc, fmt.Sprintf("/v0/corps/%s/activity", corpName), query)
+}
+
+// SiteActivityIterator returns an iterator over the activity events for
+// a given site.
+func (sc *Client) SiteActivityIterator(corpName, siteName string, query ActivityQuery) *ActivityIterator {
+	return sc.SiteActivityIteratorContext(context.Background(), corpName, siteName, query)
+}
+
+// SiteActivityIteratorContext is like SiteActivityIterator but uses the given context.
+func (sc *Client) SiteActivityIteratorContext(ctx context.Context, corpName, siteName string, query ActivityQuery) *ActivityIterator {
+	return newActivityIterator(ctx, sc, fmt.Sprintf("/v0/corps/%s/sites/%s/activity", corpName, siteName), query)
+}
+
+func newActivityIterator(ctx context.Context, sc *Client, path string, query ActivityQuery) *ActivityIterator {
+	if query.Limit <= 0 {
+		query.Limit = 100
+	}
+	if query.Page <= 0 {
+		query.Page = 1
+	}
+
+	return &ActivityIterator{
+		sc:    sc,
+		ctx:   ctx,
+		path:  path,
+		query: query,
+		next:  query.Page,
+	}
+}
+
+// Next advances the iterator to the next event, fetching the next page if
+// needed. It returns false when there are no more events, the context is
+// done or an error occurred.
+func (it *ActivityIterator) Next() bool {
+	for len(it.page) == 0 {
+		if !it.fetch() {
+			return false
+		}
+	}
+
+	it.cur, it.page = it.page[0], it.page[1:]
+
+	return true
+}
+
+func (it *ActivityIterator) fetch() bool {
+	if it.err != nil || it.done {
+		return false
+	}
+
+	if err := it.ctx.Err(); err != nil {
+		it.err = err
+		return false
+	}
+
+	page := it.next
+	path := it.uri
+	if path == "" {
+		path = withQuery(it.path, it.query.values(page))
+	}
+	resp, err := it.sc.doRequest(it.ctx, "GET", path, "")
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	var ar activityResponse
+	err = json.Unmarshal(resp, &ar)
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	it.total = ar.TotalCount
+	it.page = ar.Data
+	it.curPage = page
+	it.next = page + 1
+	it.count += len(ar.Data)
+
+	if ar.Next != nil {
+		// Follow the next URI, which is empty on the last page.
+		it.uri = nextPath(ar.Next["uri"])
+		it.done = it.uri == ""
+		if u, err := url.Parse(it.uri); err == nil {
+			if n, err := strconv.Atoi(u.Query().Get("page")); err == nil {
+				it.next = n
+			}
+		}
+	} else {
+		// Without a next URI, fall back to page numbers until an empty
+		// page or, when starting at the first page, the total count. The
+		// page size may be smaller than the limit, so a short page does
+		// not mean the last one.
+		it.done = len(ar.Data) == 0 || (it.query.Page == 1 && ar.TotalCount > 0 && it.count >= ar.TotalCount)
+	}
+
+	return true
+}
+
+// Event returns the current event.
+func (it *ActivityIterator) Event() ActivityEvent {
+	return it.cur
+}
+
+// Page returns the page number of the current event. Passing it as
+// ActivityQuery.Page resumes the iteration at that page, which may repeat
+// events of the page that were already seen.
+func (it *ActivityIterator) Page() int {
+	return it.curPage
+}
+
+// TotalCount returns the total number of events reported by the last page
+// fetched.
+func (it *ActivityIterator) TotalCount() int {
+	return it.total
+}
+
+// Err returns the error, if any, that stopped the iteration.
+func (it *ActivityIterator) Err() error {
+	return it.err
+}
@@ -0,0 +1,175 @@
+package sigsci
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRequestIterator(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v0/corps/testcorp/sites/www.mysite.com/requests" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+
+		switch r.URL.Query().Get("page") {
+		case "":
+			w.Write([]byte(`{"totalCount":3,"next":{"uri":"/api/v0/corps/testcorp/sites/www.mysite.com/requests?page=2"},"data":[{"id":"1"},{"id":"2"}]}`))
+		case "2":
+			w.Write([]byte(`{"totalCount":3,"next":{"uri":"/api/v0/corps/testcorp/sites/www.mysite.com/requests?page=3"},"data":[]}`))
+		case "3":
+			w.Write([]byte(`{"totalCount":3,"next":{"uri":""},"data":[{"id":"3"}]}`))
+		}
+	}))
+	defer ts.Close()
+
+	sc := NewTokenClient("test@test.net", "token", WithBaseURL(ts.URL+"/api"))
+	it := sc.SearchRequestsIterator("testcorp", "www.mysite.com", nil)
+
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.Request().ID)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 3 || ids[0] != "1" || ids[2] != "3" {
+		t.Errorf("got ids %v, want [1 2 3]", ids)
+	}
+	if it.TotalCount() != 3 {
+		t.Errorf("got total count %d, want 3", it.TotalCount())
+	}
+}
+
+func TestEventIteratorCanceled(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"totalCount":10,"next":{"uri":"/api/v0/corps/testcorp/sites/www.mysite.com/events?page=2"},"data":[{"id":"1"}]}`))
+	}))
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	sc := NewTokenClient("test@test.net", "token", WithBaseURL(ts.URL+"/api"))
+	it := sc.ListEventsIteratorContext(ctx, "testcorp", "www.mysite.com", nil)
+
+	if !it.Next() {
+		t.Fatal(it.Err())
+	}
+	cancel()
+	if it.Next() {
+		t.Error("Next returned true after cancel")
+	}
+	if it.Err() != context.Canceled {
+		t.Errorf("got %v, want context.Canceled", it.Err())
+	}
+}
+
+func TestActivityIterator(t *testing.T) {
+	pages := map[string]string{
+		"1": `{"totalCount":5,"data":[{"id":"1"},{"id":"2"}]}`,
+		"2": `{"totalCount":5,"data":[{"id":"3"},{"id":"4"}]}`,
+		"3": `{"totalCount":5,"data":[{"id":"5"}]}`,
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("limit") != "2" || q.Get("from") != "1500000000" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+		page, ok := pages[q.Get("page")]
+		if !ok {
+			page = `{"totalCount":5,"data":[]}`
+		}
+		w.Write([]byte(page))
+	}))
+	defer ts.Close()
+
+	sc := NewTokenClient("test@test.net", "token", WithBaseURL(ts.URL))
+	query := ActivityQuery{Limit: 2, From: time.Unix(1500000000, 0)}
+
+	it := sc.CorpActivityIterator("testcorp", query)
+	var ids []string
+	var resume int
+	for it.Next() {
+		ids = append(ids, it.Event().ID)
+		if it.Event().ID == "3" {
+			resume = it.Page()
+		}
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 5 || it.TotalCount() != 5 {
+		t.Errorf("got ids %v and total count %d", ids, it.TotalCount())
+	}
+
+	query.Page = resume
+	it = sc.SiteActivityIterator("testcorp", "www.mysite.com", query)
+	ids = nil
+	for it.Next() {
+		ids = append(ids, it.Event().ID)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 3 || ids[0] != "3" {
+		t.Errorf("got ids %v after resuming at page %d, want [3 4 5]", ids, resume)
+	}
+}
+
+func TestActivityIteratorShortPages(t *testing.T) {
+	// The server returns at most 2 events per page, fewer than the limit.
+	events := `[{"id":"1"},{"id":"2"}]`
+	for _, tc := range []struct {
+		name  string
+		pages map[string]string
+	}{
+		{
+			name: "next",
+			pages: map[string]string{
+				"1": `{"totalCount":5,"next":{"uri":"/api/v0/corps/testcorp/activity?limit=5&page=2"},"data":` + events + `}`,
+				"2": `{"totalCount":5,"next":{"uri":"/api/v0/corps/testcorp/activity?limit=5&page=3"},"data":[]}`,
+				"3": `{"totalCount":5,"next":{"uri":"/api/v0/corps/testcorp/activity?limit=5&page=4"},"data":` + events + `}`,
+				"4": `{"totalCount":5,"next":{"uri":""},"data":[{"id":"5"}]}`,
+			},
+		},
+		{
+			name: "page numbers",
+			pages: map[string]string{
+				"1": `{"totalCount":5,"data":` + events + `}`,
+				"2": `{"totalCount":5,"data":` + events + `}`,
+				"3": `{"totalCount":5,"data":[{"id":"5"}]}`,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var requests int
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				page, ok := tc.pages[r.URL.Query().Get("page")]
+				if !ok {
+					t.Errorf("unexpected request %s", r.URL)
+				}
+				w.Write([]byte(page))
+			}))
+			defer ts.Close()
+
+			sc := NewTokenClient("test@test.net", "token", WithBaseURL(ts.URL+"/api"))
+			it := sc.CorpActivityIterator("testcorp", ActivityQuery{Limit: 5})
+
+			var n int
+			for it.Next() {
+				n++
+			}
+			if err := it.Err(); err != nil {
+				t.Fatal(err)
+			}
+			if n != 5 {
+				t.Errorf("got %d events, want 5", n)
+			}
+			if requests != len(tc.pages) {
+				t.Errorf("got %d requests, want %d", requests, len(tc.pages))
+			}
+		})
+	}
+}
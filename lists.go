@@ -0,0 +1,335 @@
+package sigsci
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ListType is the type of the entries of a list.
+type ListType string
+
+// All available ListTypes
+const (
+	ListTypeIP       = ListType("ip")
+	ListTypeCountry  = ListType("country")
+	ListTypeString   = ListType("string")
+	ListTypeWildcard = ListType("wildcard")
+)
+
+// List contains the data for a site or corp list, which rules can
+// reference with the inList and notInList operators.
+type List struct {
+	ID          string
+	Name        string
+	Type        ListType
+	Description string
+	Entries     []string
+	CreatedBy   string
+	Created     time.Time
+	Updated     time.Time
+}
+
+// listsResponse is the response for the list lists endpoints.
+type listsResponse struct {
+	Data []List
+}
+
+// CreateListBody is the body for creating a list.
+type CreateListBody struct {
+	Name        string   `json:"name"`
+	Type        ListType `json:"type"`
+	Description string   `json:"description"`
+	Entries     []string `json:"entries"`
+}
+
+// Validate checks the list body for errors the API would reject.
+func (b CreateListBody) Validate() error {
+	if b.Name == "" {
+		return errors.New("list requires a name")
+	}
+
+	switch b.Type {
+	case ListTypeIP, ListTypeCountry, ListTypeString, ListTypeWildcard:
+	default:
+		return fmt.Errorf("invalid list type %q", b.Type)
+	}
+
+	return nil
+}
+
+// ListEntries are the entries to add to and remove from a list.
+type ListEntries struct {
+	Additions []string `json:"additions,omitempty"`
+	Deletions []string `json:"deletions,omitempty"`
+}
+
+// UpdateListBody is the body for updating a list.
+type UpdateListBody struct {
+	Description *string     `json:"description,omitempty"`
+	Entries     ListEntries `json:"entries"`
+}
+
+// ListSiteLists lists site lists.
+func (sc *Client) ListSiteLists(corpName, siteName string) ([]List, error) {
+	return sc.ListSiteListsContext(context.Background(), corpName, siteName)
+}
+
+// ListSiteListsContext is like ListSiteLists but uses the given context.
+func (sc *Client) ListSiteListsContext(ctx context.Context, corpName, siteName string) ([]List, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/sites/%s/lists", corpName, siteName), "")
+	if err != nil {
+		return []List{}, err
+	}
+
+	var lr listsResponse
+	err = json.Unmarshal(resp, &lr)
+	if err != nil {
+		return []List{}, err
+	}
+
+	return lr.Data, nil
+}
+
+// CreateSiteList creates a site list.
+func (sc *Client) CreateSiteList(corpName, siteName string, body CreateListBody) (List, error) {
+	return sc.CreateSiteListContext(context.Background(), corpName, siteName, body)
+}
+
+// CreateSiteListContext is like CreateSiteList but uses the given context.
+func (sc *Client) CreateSiteListContext(ctx context.Context, corpName, siteName string, body CreateListBody) (List, error) {
+	if err := body.Validate(); err != nil {
+		return List{}, err
+	}
+
+	b, err := json.Marshal(body)
+	if err != nil {
+		return List{}, err
+	}
+
+	resp, err := sc.doRequest(ctx, "POST", fmt.Sprintf("/v0/corps/%s/sites/%s/lists", corpName, siteName), string(b))
+	if err != nil {
+		return List{}, err
+	}
+
+	var l List
+	err = json.Unmarshal(resp, &l)
+	if err != nil {
+		return List{}, err
+	}
+
+	return l, nil
+}
+
+// GetSiteList gets a site list by id.
+func (sc *Client) GetSiteList(corpName, siteName, id string) (List, error) {
+	return sc.GetSiteListContext(context.Background(), corpName, siteName, id)
+}
+
+// GetSiteListContext is like GetSiteList but uses the given context.
+func (sc *Client) GetSiteListContext(ctx context.Context, corpName, siteName, id string) (List, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/sites/%s/lists/%s", corpName, siteName, id), "")
+	if err != nil {
+		return List{}, err
+	}
+
+	var l List
+	err = json.Unmarshal(resp, &l)
+	if err != nil {
+		return List{}, err
+	}
+
+	return l, nil
+}
+
+// UpdateSiteList updates the description of a site list and adds or
+// removes entries, without sending the entries that do not change.
+func (sc *Client) UpdateSiteList(corpName, siteName, id string, body UpdateListBody) (List, error) {
+	return sc.UpdateSiteListContext(context.Background(), corpName, siteName, id, body)
+}
+
+// UpdateSiteListContext is like UpdateSiteList but uses the given context.
+func (sc *Client) UpdateSiteListContext(ctx context.Context, corpName, siteName, id string, body UpdateListBody) (List, error) {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return List{}, err
+	}
+
+	resp, err := sc.doRequest(ctx, "PATCH", fmt.Sprintf("/v0/corps/%s/sites/%s/lists/%s", corpName, siteName, id), string(b))
+	if err != nil {
+		return List{}, err
+	}
+
+	var l List
+	err = json.Unmarshal(resp, &l)
+	if err != nil {
+		return List{}, err
+	}
+
+	return l, nil
+}
+
+// AddSiteListEntries adds entries to a site list.
+func (sc *Client) AddSiteListEntries(corpName, siteName, id string, entries []string) (List, error) {
+	return sc.AddSiteListEntriesContext(context.Background(), corpName, siteName, id, entries)
+}
+
+// AddSiteListEntriesContext is like AddSiteListEntries but uses the given context.
+func (sc *Client) AddSiteListEntriesContext(ctx context.Context, corpName, siteName, id string, entries []string) (List, error) {
+	return sc.UpdateSiteListContext(ctx, corpName, siteName, id, UpdateListBody{Entries: ListEntries{Additions: entries}})
+}
+
+// RemoveSiteListEntries removes entries from a site list.
+func (sc *Client) RemoveSiteListEntries(corpName, siteName, id string, entries []string) (List, error) {
+	return sc.RemoveSiteListEntriesContext(context.Background(), corpName, siteName, id, entries)
+}
+
+// RemoveSiteListEntriesContext is like RemoveSiteListEntries but uses the given context.
+func (sc *Client) RemoveSiteListEntriesContext(ctx context.Context, corpName, siteName, id string, entries []string) (List, error) {
+	return sc.UpdateSiteListContext(ctx, corpName, siteName, id, UpdateListBody{Entries: ListEntries{Deletions: entries}})
+}
+
+// DeleteSiteList deletes a site list by id.
+func (sc *Client) DeleteSiteList(corpName, siteName, id string) error {
+	return sc.DeleteSiteListContext(context.Background(), corpName, siteName, id)
+}
+
+// DeleteSiteListContext is like DeleteSiteList but uses the given context.
+func (sc *Client) DeleteSiteListContext(ctx context.Context, corpName, siteName, id string) error {
+	_, err := sc.doRequest(ctx, "DELETE", fmt.Sprintf("/v0/corps/%s/sites/%s/lists/%s", corpName, siteName, id), "")
+
+	return err
+}
+
+// ListCorpLists lists corp lists.
+func (sc *Client) ListCorpLists(corpName string) ([]List, error) {
+	return sc.ListCorpListsContext(context.Background(), corpName)
+}
+
+// ListCorpListsContext is like ListCorpLists but uses the given context.
+func (sc *Client) ListCorpListsContext(ctx context.Context, corpName string) ([]List, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/lists", corpName), "")
+	if err != nil {
+		return []List{}, err
+	}
+
+	var lr listsResponse
+	err = json.Unmarshal(resp, &lr)
+	if err != nil {
+		return []List{}, err
+	}
+
+	return lr.Data, nil
+}
+
+// CreateCorpList creates a corp list.
+func (sc *Client) CreateCorpList(corpName string, body CreateListBody) (List, error) {
+	return sc.CreateCorpListContext(context.Background(), corpName, body)
+}
+
+// CreateCorpListContext is like CreateCorpList but uses the given context.
+func (sc *Client) CreateCorpListContext(ctx context.Context, corpName string, body CreateListBody) (List, error) {
+	if err := body.Validate(); err != nil {
+		return List{}, err
+	}
+
+	b, err := json.Marshal(body)
+	if err != nil {
+		return List{}, err
+	}
+
+	resp, err := sc.doRequest(ctx, "POST", fmt.Sprintf("/v0/corps/%s/lists", corpName), string(b))
+	if err != nil {
+		return List{}, err
+	}
+
+	var l List
+	err = json.Unmarshal(resp, &l)
+	if err != nil {
+		return List{}, err
+	}
+
+	return l, nil
+}
+
+// GetCorpList gets a corp list by id.
+func (sc *Client) GetCorpList(corpName, id string) (List, error) {
+	return sc.GetCorpListContext(context.Background(), corpName, id)
+}
+
+// GetCorpListContext is like GetCorpList but uses the given context.
+func (sc *Client) GetCorpListContext(ctx context.Context, corpName, id string) (List, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/lists/%s", corpName, id), "")
+	if err != nil {
+		return List{}, err
+	}
+
+	var l List
+	err = json.Unmarshal(resp, &l)
+	if err != nil {
+		return List{}, err
+	}
+
+	return l, nil
+}
+
+// UpdateCorpList updates the description of a corp list and adds or
+// removes entries, without sending the entries that do not change.
+func (sc *Client) UpdateCorpList(corpName, id string, body UpdateListBody) (List, error) {
+	return sc.UpdateCorpListContext(context.Background(), corpName, id, body)
+}
+
+// UpdateCorpListContext is like UpdateCorpList but uses the given context.
+func (sc *Client) UpdateCorpListContext(ctx context.Context, corpName, id string, body UpdateListBody) (List, error) {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return List{}, err
+	}
+
+	resp, err := sc.doRequest(ctx, "PATCH", fmt.Sprintf("/v0/corps/%s/lists/%s", corpName, id), string(b))
+	if err != nil {
+		return List{}, err
+	}
+
+	var l List
+	err = json.Unmarshal(resp, &l)
+	if err != nil {
+		return List{}, err
+	}
+
+	return l, nil
+}
+
+// AddCorpListEntries adds entries to a corp list.
+func (sc *Client) AddCorpListEntries(corpName, id string, entries []string) (List, error) {
+	return sc.AddCorpListEntriesContext(context.Background(), corpName, id, entries)
+}
+
+// AddCorpListEntriesContext is like AddCorpListEntries but uses the given context.
+func (sc *Client) AddCorpListEntriesContext(ctx context.Context, corpName, id string, entries []string) (List, error) {
+	return sc.UpdateCorpListContext(ctx, corpName, id, UpdateListBody{Entries: ListEntries{Additions: entries}})
+}
+
+// RemoveCorpListEntries removes entries from a corp list.
+func (sc *Client) RemoveCorpListEntries(corpName, id string, entries []string) (List, error) {
+	return sc.RemoveCorpListEntriesContext(context.Background(), corpName, id, entries)
+}
+
+// RemoveCorpListEntriesContext is like RemoveCorpListEntries but uses the given context.
+func (sc *Client) RemoveCorpListEntriesContext(ctx context.Context, corpName, id string, entries []string) (List, error) {
+	return sc.UpdateCorpListContext(ctx, corpName, id, UpdateListBody{Entries: ListEntries{Deletions: entries}})
+}
+
+// DeleteCorpList deletes a corp list by id.
+func (sc *Client) DeleteCorpList(corpName, id string) error {
+	return sc.DeleteCorpListContext(context.Background(), corpName, id)
+}
+
+// DeleteCorpListContext is like DeleteCorpList but uses the given context.
+func (sc *Client) DeleteCorpListContext(ctx context.Context, corpName, id string) error {
+	_, err := sc.doRequest(ctx, "DELETE", fmt.Sprintf("/v0/corps/%s/lists/%s", corpName, id), "")
+
+	return err
+}
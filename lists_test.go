@@ -0,0 +1,68 @@
+package sigsci
+
+import "testing"
+
+func TestCreateListBodyValidate(t *testing.T) {
+	valid := CreateListBody{Name: "scanners", Type: ListTypeIP, Entries: []string{"10.0.0.1"}}
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("valid list: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*CreateListBody)
+	}{
+		{"no name", func(b *CreateListBody) { b.Name = "" }},
+		{"no type", func(b *CreateListBody) { b.Type = "" }},
+		{"bad type", func(b *CreateListBody) { b.Type = "regex" }},
+	}
+	for _, tt := range tests {
+		b := valid
+		tt.modify(&b)
+		if err := b.Validate(); err == nil {
+			t.Errorf("%s: expected error", tt.name)
+		}
+	}
+}
+
+func TestUpdateList(t *testing.T) {
+	list := List{ID: "1", Name: "scanners", Type: ListTypeIP, Entries: []string{"10.0.0.1", "10.0.0.2"}}
+	response := `{"id":"1","name":"scanners","type":"ip","entries":["10.0.0.1","10.0.0.2"]}`
+	empty := ""
+
+	runRequestTests(t, []requestTest{
+		{
+			name: "add site entries",
+			call: func(sc *Client) (interface{}, error) {
+				return sc.AddSiteListEntries("testcorp", "www.mysite.com", "1", []string{"10.0.0.2"})
+			},
+			method:   "PATCH",
+			path:     "/v0/corps/testcorp/sites/www.mysite.com/lists/1",
+			body:     `{"entries":{"additions":["10.0.0.2"]}}`,
+			response: response,
+			want:     list,
+		},
+		{
+			name: "remove corp entries",
+			call: func(sc *Client) (interface{}, error) {
+				return sc.RemoveCorpListEntries("testcorp", "1", []string{"10.0.0.3"})
+			},
+			method:   "PATCH",
+			path:     "/v0/corps/testcorp/lists/1",
+			body:     `{"entries":{"deletions":["10.0.0.3"]}}`,
+			response: response,
+			want:     list,
+		},
+		{
+			name: "clear description",
+			call: func(sc *Client) (interface{}, error) {
+				return sc.UpdateCorpList("testcorp", "1", UpdateListBody{Description: &empty})
+			},
+			method:   "PATCH",
+			path:     "/v0/corps/testcorp/lists/1",
+			body:     `{"description":"","entries":{}}`,
+			response: response,
+			want:     list,
+		},
+	})
+}
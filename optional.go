@@ -0,0 +1,19 @@
+package sigsci
+
+// String returns a pointer to v, for setting optional fields of update
+// bodies such as UpdateSiteBody.
+func String(v string) *string {
+	return &v
+}
+
+// Int returns a pointer to v, for setting optional fields of update
+// bodies such as UpdateSiteBody.
+func Int(v int) *int {
+	return &v
+}
+
+// Bool returns a pointer to v, for setting optional fields of update
+// bodies such as UpdateTemplatedRuleAlertBody.
+func Bool(v bool) *bool {
+	return &v
+}
@@ -0,0 +1,29 @@
+package sigsci
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestUpdateBodyOptionalFields(t *testing.T) {
+	tests := []struct {
+		body interface{}
+		want string
+	}{
+		{UpdateSiteBody{}, `{}`},
+		{UpdateSiteBody{BlockDurationSeconds: Int(0), DisplayName: String("")}, `{"displayName":"","blockDurationSeconds":0}`},
+		{UpdateSiteBody{BlockHTTPCode: Int(406)}, `{"blockHTTPCode":406}`},
+		{UpdateCorpBody{SessionMaxAgeDashboard: Int(0)}, `{"sessionMaxAgeDashboard":0}`},
+		{UpdateRedactionBody{RedactionType: Int(0)}, `{"redactionType":0}`},
+	}
+
+	for _, tt := range tests {
+		b, err := json.Marshal(tt.body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != tt.want {
+			t.Errorf("got %s, want %s", b, tt.want)
+		}
+	}
+}
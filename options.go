@@ -0,0 +1,97 @@
+package sigsci
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ClientOption configures a Client created by NewClient or NewTokenClient.
+type ClientOption func(*Client)
+
+// WithBaseURL sets the base URL of the API, e.g. for pointing the client
+// at a staging dashboard or a test server. The default is
+// https://dashboard.signalsciences.net/api.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(sc *Client) {
+		sc.baseURL = strings.TrimRight(baseURL, "/")
+	}
+}
+
+// WithHTTPClient sets the HTTP client used for all API calls.
+func WithHTTPClient(c *http.Client) ClientOption {
+	return func(sc *Client) {
+		sc.httpClient = c
+	}
+}
+
+// WithTransport sets the round tripper used for all API calls. It does not
+// modify an *http.Client passed to WithHTTPClient.
+func WithTransport(rt http.RoundTripper) ClientOption {
+	return func(sc *Client) {
+		c := *sc.client()
+		c.Transport = rt
+		sc.httpClient = &c
+	}
+}
+
+// WithUserAgent appends suffix to the User-Agent header sent with every
+// request.
+func WithUserAgent(suffix string) ClientOption {
+	return func(sc *Client) {
+		sc.userAgent = suffix
+	}
+}
+
+// WithTimeout sets a timeout applied to every API call. Deadlines already
+// present on a call's context still apply.
+func WithTimeout(d time.Duration) ClientOption {
+	return func(sc *Client) {
+		sc.timeout = d
+	}
+}
+
+// newClient returns a Client with defaults applied and then opts.
+func newClient(opts []ClientOption) Client {
+	sc := Client{
+		httpClient: &http.Client{},
+	}
+	for _, opt := range opts {
+		opt(&sc)
+	}
+
+	return sc
+}
+
+func (sc *Client) apiURL() string {
+	if sc.baseURL != "" {
+		return sc.baseURL
+	}
+
+	return defaultAPIURL
+}
+
+func (sc *Client) client() *http.Client {
+	if sc.httpClient != nil {
+		return sc.httpClient
+	}
+
+	return http.DefaultClient
+}
+
+func (sc *Client) userAgentString() string {
+	if sc.userAgent != "" {
+		return "go-sigsci " + sc.userAgent
+	}
+
+	return "go-sigsci"
+}
+
+func (sc *Client) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if sc.timeout > 0 {
+		return context.WithTimeout(ctx, sc.timeout)
+	}
+
+	return context.WithCancel(ctx)
+}
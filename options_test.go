@@ -0,0 +1,66 @@
+package sigsci
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestClientOptions(t *testing.T) {
+	var gotUA, gotPath string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotUA = r.Header.Get("User-Agent")
+		gotPath = r.URL.Path
+		w.Write([]byte(`{"data":[{"name":"testcorp"}]}`))
+	}))
+	defer ts.Close()
+
+	sc := NewTokenClient("test@test.net", "token",
+		WithBaseURL(ts.URL+"/api/"),
+		WithUserAgent("mytool/1.0"),
+	)
+
+	corps, err := sc.ListCorps()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(corps) != 1 || corps[0].Name != "testcorp" {
+		t.Errorf("unexpected corps: %+v", corps)
+	}
+	if gotPath != "/api/v0/corps" {
+		t.Errorf("got path %q, want /api/v0/corps", gotPath)
+	}
+	if gotUA != "go-sigsci mytool/1.0" {
+		t.Errorf("got user agent %q", gotUA)
+	}
+}
+
+func TestClientTimeout(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	}))
+	defer ts.Close()
+
+	sc := NewTokenClient("test@test.net", "token",
+		WithBaseURL(ts.URL),
+		WithTimeout(10*time.Millisecond),
+	)
+
+	_, err := sc.ListCorps()
+	if err == nil {
+		t.Fatal("expected timeout error")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	sc = NewTokenClient("test@test.net", "token", WithBaseURL(ts.URL))
+	_, err = sc.ListCorpsContext(ctx)
+	if err == nil {
+		t.Fatal("expected error for canceled context")
+	}
+}
@@ -0,0 +1,130 @@
+package sigsci
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token bucket rate limiter. It is safe for concurrent use
+// and may be shared by several clients.
+type RateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter returns a rate limiter that allows rps calls per second on
+// average with bursts of up to burst calls.
+func NewRateLimiter(rps float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &RateLimiter{
+		rate:   rps,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until a call is allowed or ctx is done. It returns ctx's
+// error without taking a token if ctx is already done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	if l == nil || l.rate <= 0 {
+		return nil
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+
+	// Reserve a token, going into debt if none is available, and wait
+	// until the debt is paid off.
+	l.tokens--
+	if l.tokens >= 0 {
+		l.mu.Unlock()
+		return nil
+	}
+	wait := time.Duration(-l.tokens / l.rate * float64(time.Second))
+	l.mu.Unlock()
+
+	if err := sleepContext(ctx, wait); err != nil {
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return err
+	}
+
+	return nil
+}
+
+// EndpointClass is a group of API endpoints that can be rate limited
+// separately.
+type EndpointClass string
+
+// All available EndpointClasses
+const (
+	// EndpointClassManagement covers all endpoints not in another class.
+	EndpointClassManagement = EndpointClass("management")
+	// EndpointClassRequests covers request search and lookup.
+	EndpointClassRequests = EndpointClass("requests")
+	// EndpointClassRequestFeed covers the request feed.
+	EndpointClassRequestFeed = EndpointClass("requestFeed")
+)
+
+// endpointClass returns the class of the endpoint at path.
+func endpointClass(path string) EndpointClass {
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+
+	switch {
+	case strings.HasSuffix(path, "/feed/requests"):
+		return EndpointClassRequestFeed
+	case strings.Contains(path, "/sites/") && (strings.HasSuffix(path, "/requests") || strings.Contains(path, "/requests/")):
+		return EndpointClassRequests
+	default:
+		return EndpointClassManagement
+	}
+}
+
+// WithRateLimiter makes all API calls wait for l. Limiters set for an
+// endpoint class with WithEndpointRateLimiter take precedence.
+func WithRateLimiter(l *RateLimiter) ClientOption {
+	return func(sc *Client) {
+		sc.limiter = l
+	}
+}
+
+// WithEndpointRateLimiter makes API calls to endpoints in class wait for l.
+func WithEndpointRateLimiter(class EndpointClass, l *RateLimiter) ClientOption {
+	return func(sc *Client) {
+		limiters := make(map[EndpointClass]*RateLimiter, len(sc.classLimiters)+1)
+		for k, v := range sc.classLimiters {
+			limiters[k] = v
+		}
+		limiters[class] = l
+		sc.classLimiters = limiters
+	}
+}
+
+// waitRateLimit waits for the rate limiter that applies to path, if any.
+func (sc *Client) waitRateLimit(ctx context.Context, path string) error {
+	if l, ok := sc.classLimiters[endpointClass(path)]; ok {
+		return l.Wait(ctx)
+	}
+
+	return sc.limiter.Wait(ctx)
+}
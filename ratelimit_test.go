@@ -0,0 +1,64 @@
+package sigsci
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter(t *testing.T) {
+	l := NewRateLimiter(50, 2)
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		if err := l.Wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Two calls fit in the burst, the other two wait 20ms each.
+	if d := time.Since(start); d < 35*time.Millisecond {
+		t.Errorf("4 calls took %v, want at least 40ms", d)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := l.Wait(ctx); err != context.Canceled {
+		t.Errorf("got %v, want context.Canceled", err)
+	}
+}
+
+func TestRateLimiterCanceled(t *testing.T) {
+	l := NewRateLimiter(0.001, 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := l.Wait(ctx); err != context.Canceled {
+		t.Errorf("got %v, want context.Canceled", err)
+	}
+
+	// The canceled call must not have taken the only token.
+	ctx, cancel = context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := l.Wait(ctx); err != nil {
+		t.Errorf("got %v, want the token to be available", err)
+	}
+}
+
+func TestEndpointClass(t *testing.T) {
+	tests := []struct {
+		path string
+		want EndpointClass
+	}{
+		{"/v0/corps/testcorp/sites/www.mysite.com/feed/requests?from=1", EndpointClassRequestFeed},
+		{"/v0/corps/testcorp/sites/www.mysite.com/requests?q=from:-1h", EndpointClassRequests},
+		{"/v0/corps/testcorp/sites/www.mysite.com/requests/123", EndpointClassRequests},
+		{"/v0/corps/testcorp/sites/www.mysite.com/agents", EndpointClassManagement},
+		{"/v0/corps", EndpointClassManagement},
+	}
+
+	for _, tt := range tests {
+		if got := endpointClass(tt.path); got != tt.want {
+			t.Errorf("endpointClass(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package sigsci
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures automatic retries of failed API calls. Calls are
+// retried on network errors, 429 Too Many Requests and 5xx responses other
+// than 501 Not Implemented.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts per call, including
+	// the first one. Values below 2 disable retries.
+	MaxAttempts int
+	// MinBackoff and MaxBackoff bound the exponential backoff between
+	// attempts. A random jitter of up to half the backoff is subtracted.
+	// MinBackoff defaults to 500ms; without MaxBackoff, the backoff keeps
+	// doubling.
+	// A Retry-After response header is honored instead of the backoff,
+	// but if it asks to wait longer than MaxBackoff, the call is not
+	// retried and the error is returned.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	// RetryNonIdempotent enables retries of POST and PATCH calls, which
+	// may not be safe to repeat. By default only GET, PUT and DELETE calls
+	// are retried.
+	RetryNonIdempotent bool
+	// OnRetry, if set, is called before waiting for each retry.
+	OnRetry func(RetryInfo)
+}
+
+// RetryInfo describes a failed attempt that is about to be retried.
+type RetryInfo struct {
+	// Attempt is the number of the attempt that failed, starting at 1.
+	Attempt int
+	Method  string
+	Path    string
+	// Wait is how long the client waits before the next attempt.
+	Wait time.Duration
+	// Err is the error of the failed attempt.
+	Err error
+}
+
+// defaultMinBackoff is the backoff before the first retry if the policy
+// does not set MinBackoff.
+const defaultMinBackoff = 500 * time.Millisecond
+
+// DefaultRetryPolicy returns a retry policy with 4 attempts and a backoff
+// between 500ms and 30s.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 4,
+		MinBackoff:  defaultMinBackoff,
+		MaxBackoff:  30 * time.Second,
+	}
+}
+
+// WithRetryPolicy enables automatic retries of failed API calls.
+func WithRetryPolicy(p RetryPolicy) ClientOption {
+	return func(sc *Client) {
+		sc.retry = p
+	}
+}
+
+// backoff reports whether the failed attempt should be retried and how
+// long to wait before doing so.
+func (p RetryPolicy) backoff(ctx context.Context, method string, attempt int, err error) (time.Duration, bool) {
+	if attempt >= p.MaxAttempts || ctx.Err() != nil {
+		return 0, false
+	}
+
+	switch method {
+	case "GET", "HEAD", "PUT", "DELETE":
+	default:
+		if !p.RetryNonIdempotent {
+			return 0, false
+		}
+	}
+
+	e, ok := asAPIError(err)
+	switch {
+	case ok:
+		switch {
+		case e.StatusCode == http.StatusTooManyRequests:
+		case e.StatusCode >= 500 && e.StatusCode != http.StatusNotImplemented:
+		default:
+			return 0, false
+		}
+
+		if wait, ok := retryAfter(e.Header); ok {
+			if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+				return 0, false
+			}
+			return wait, true
+		}
+	case !isNetworkError(err):
+		return 0, false
+	}
+
+	d := p.MinBackoff
+	if d <= 0 {
+		d = defaultMinBackoff
+	}
+	for i := 1; i < attempt && d < math.MaxInt64/2; i++ {
+		if p.MaxBackoff > 0 && d >= p.MaxBackoff {
+			break
+		}
+		d *= 2
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	if d > 1 {
+		d -= time.Duration(rand.Int63n(int64(d / 2)))
+	}
+
+	return d, true
+}
+
+// isNetworkError reports whether err is an error sending a request or
+// reading its response, as opposed to e.g. an invalid request URL.
+func isNetworkError(err error) bool {
+	if e, ok := err.(*url.Error); ok {
+		return e.Op != "parse"
+	}
+	_, ok := err.(net.Error)
+	return ok
+}
+
+// retryAfter parses the Retry-After header, which is either a number of
+// seconds or an HTTP date.
+func retryAfter(h http.Header) (time.Duration, bool) {
+	v := h.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+
+	if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+
+	return 0, false
+}
+
+// sleepContext waits for d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,159 @@
+package sigsci
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	var calls int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		switch calls {
+		case 1:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			w.Write([]byte(`{"data":[]}`))
+		}
+	}))
+	defer ts.Close()
+
+	var retries []RetryInfo
+	sc := NewTokenClient("test@test.net", "token",
+		WithBaseURL(ts.URL),
+		WithRetryPolicy(RetryPolicy{
+			MaxAttempts: 3,
+			MinBackoff:  time.Millisecond,
+			MaxBackoff:  5 * time.Millisecond,
+			OnRetry: func(ri RetryInfo) {
+				retries = append(retries, ri)
+			},
+		}),
+	)
+
+	if _, err := sc.ListSites("testcorp"); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 3 {
+		t.Errorf("got %d calls, want 3", calls)
+	}
+	if len(retries) != 2 {
+		t.Fatalf("got %d retries, want 2", len(retries))
+	}
+	if retries[0].Wait != 0 || !IsRateLimited(retries[0].Err) {
+		t.Errorf("unexpected first retry: %+v", retries[0])
+	}
+	if !IsServerError(retries[1].Err) {
+		t.Errorf("unexpected second retry: %+v", retries[1])
+	}
+}
+
+func TestRetryPolicyNonIdempotent(t *testing.T) {
+	var calls int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+
+	p := RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond}
+	sc := NewTokenClient("test@test.net", "token", WithBaseURL(ts.URL), WithRetryPolicy(p))
+	if _, err := sc.ExpireEvent("testcorp", "www.mysite.com", "123"); err == nil {
+		t.Fatal("expected error")
+	}
+	if calls != 1 {
+		t.Errorf("got %d calls for POST, want 1", calls)
+	}
+
+	calls = 0
+	p.RetryNonIdempotent = true
+	sc = NewTokenClient("test@test.net", "token", WithBaseURL(ts.URL), WithRetryPolicy(p))
+	if _, err := sc.ExpireEvent("testcorp", "www.mysite.com", "123"); err == nil {
+		t.Fatal("expected error")
+	}
+	if calls != 3 {
+		t.Errorf("got %d calls for POST with RetryNonIdempotent, want 3", calls)
+	}
+}
+
+func TestRetryPolicyLongRetryAfter(t *testing.T) {
+	var calls int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Retry-After", "86400")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer ts.Close()
+
+	sc := NewTokenClient("test@test.net", "token",
+		WithBaseURL(ts.URL),
+		WithRetryPolicy(DefaultRetryPolicy()),
+	)
+
+	if _, err := sc.ListSites("testcorp"); !IsRateLimited(err) {
+		t.Fatalf("got %v, want rate limited", err)
+	}
+	if calls != 1 {
+		t.Errorf("got %d calls, want 1", calls)
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	netErr := &url.Error{Op: "Get", URL: "https://dashboard.signalsciences.net/api/v0/corps", Err: errors.New("connection reset")}
+
+	tests := []struct {
+		name     string
+		policy   RetryPolicy
+		attempt  int
+		err      error
+		min, max time.Duration
+		retry    bool
+	}{
+		{"first", RetryPolicy{MaxAttempts: 5, MinBackoff: 100 * time.Millisecond}, 1, netErr, 50 * time.Millisecond, 100 * time.Millisecond, true},
+		{"second", RetryPolicy{MaxAttempts: 5, MinBackoff: 100 * time.Millisecond}, 2, netErr, 100 * time.Millisecond, 200 * time.Millisecond, true},
+		{"third", RetryPolicy{MaxAttempts: 5, MinBackoff: 100 * time.Millisecond}, 3, netErr, 200 * time.Millisecond, 400 * time.Millisecond, true},
+		{"capped", RetryPolicy{MaxAttempts: 5, MinBackoff: 100 * time.Millisecond, MaxBackoff: 250 * time.Millisecond}, 4, netErr, 125 * time.Millisecond, 250 * time.Millisecond, true},
+		{"default min", RetryPolicy{MaxAttempts: 5}, 1, netErr, 250 * time.Millisecond, 500 * time.Millisecond, true},
+		{"server error", RetryPolicy{MaxAttempts: 5}, 2, &APIError{StatusCode: http.StatusServiceUnavailable}, 500 * time.Millisecond, time.Second, true},
+		{"last attempt", RetryPolicy{MaxAttempts: 5}, 5, netErr, 0, 0, false},
+		{"not found", RetryPolicy{MaxAttempts: 5}, 1, &APIError{StatusCode: http.StatusNotFound}, 0, 0, false},
+		{"bad url", RetryPolicy{MaxAttempts: 5}, 1, &url.Error{Op: "parse", URL: "://bad", Err: errors.New("missing protocol scheme")}, 0, 0, false},
+		{"other error", RetryPolicy{MaxAttempts: 5}, 1, errors.New("invalid character"), 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		d, ok := tt.policy.backoff(context.Background(), "GET", tt.attempt, tt.err)
+		if ok != tt.retry {
+			t.Errorf("%s: got retry %v, want %v", tt.name, ok, tt.retry)
+		}
+		if ok && (d <= tt.min || d > tt.max) {
+			t.Errorf("%s: got backoff %v, want between %v and %v", tt.name, d, tt.min, tt.max)
+		}
+	}
+}
+
+func TestRetryPolicyBadURL(t *testing.T) {
+	var retries int
+	sc := NewTokenClient("test@test.net", "token",
+		WithBaseURL("://bad"),
+		WithRetryPolicy(RetryPolicy{
+			MaxAttempts: 3,
+			OnRetry:     func(RetryInfo) { retries++ },
+		}),
+	)
+
+	if _, err := sc.ListSites("testcorp"); err == nil {
+		t.Fatal("expected error")
+	}
+	if retries != 0 {
+		t.Errorf("got %d retries, want 0", retries)
+	}
+}
@@ -0,0 +1,642 @@
+package sigsci
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// RuleType is the type of a rule.
+type RuleType string
+
+// All available RuleTypes
+const (
+	RuleTypeRequest         = RuleType("request")
+	RuleTypeSignal          = RuleType("signal")
+	RuleTypeRateLimit       = RuleType("rateLimit")
+	RuleTypeTemplatedSignal = RuleType("templatedSignal")
+)
+
+// GroupOperator combines the conditions of a rule or condition group.
+type GroupOperator string
+
+// All available GroupOperators
+const (
+	GroupOperatorAll = GroupOperator("all")
+	GroupOperatorAny = GroupOperator("any")
+)
+
+// ConditionType is the type of a rule condition.
+type ConditionType string
+
+// All available ConditionTypes
+const (
+	ConditionTypeSingle   = ConditionType("single")
+	ConditionTypeGroup    = ConditionType("group")
+	ConditionTypeMultival = ConditionType("multival")
+)
+
+// ConditionOperator compares a request field to a condition value.
+type ConditionOperator string
+
+// All available ConditionOperators
+const (
+	OperatorEquals         = ConditionOperator("equals")
+	OperatorDoesNotEqual   = ConditionOperator("doesNotEqual")
+	OperatorContains       = ConditionOperator("contains")
+	OperatorDoesNotContain = ConditionOperator("doesNotContain")
+	OperatorLike           = ConditionOperator("like")
+	OperatorNotLike        = ConditionOperator("notLike")
+	OperatorMatches        = ConditionOperator("matches")
+	OperatorDoesNotMatch   = ConditionOperator("doesNotMatch")
+	OperatorExists         = ConditionOperator("exists")
+	OperatorDoesNotExist   = ConditionOperator("doesNotExist")
+	OperatorInList         = ConditionOperator("inList")
+	OperatorNotInList      = ConditionOperator("notInList")
+	OperatorGreaterEqual   = ConditionOperator("greaterEqual")
+	OperatorLesserEqual    = ConditionOperator("lesserEqual")
+)
+
+// ActionType is the type of a rule action.
+type ActionType string
+
+// All available ActionTypes
+const (
+	ActionTypeBlock         = ActionType("block")
+	ActionTypeAllow         = ActionType("allow")
+	ActionTypeAddSignal     = ActionType("addSignal")
+	ActionTypeExcludeSignal = ActionType("excludeSignal")
+
+	// Actions of rate limit rules
+	ActionTypeBlockSignal = ActionType("blockSignal")
+	ActionTypeLogRequest  = ActionType("logRequest")
+)
+
+// Condition is a rule condition. A single condition compares Field to
+// Value using Operator, a group condition combines Conditions using
+// GroupOperator, and a multival condition applies Conditions to each
+// value of a multi-valued Field such as a header or a signal.
+type Condition struct {
+	Type          ConditionType     `json:"type"`
+	GroupOperator GroupOperator     `json:"groupOperator,omitempty"`
+	Field         string            `json:"field,omitempty"`
+	Operator      ConditionOperator `json:"operator,omitempty"`
+	Value         string            `json:"value,omitempty"`
+	Conditions    []Condition       `json:"conditions,omitempty"`
+}
+
+// Action is a rule action.
+type Action struct {
+	Type ActionType `json:"type"`
+	// Signal is the signal to add, exclude or block.
+	Signal string `json:"signal,omitempty"`
+}
+
+// ClientIdentifierType is the part of a request that identifies a client
+// for rate limiting.
+type ClientIdentifierType string
+
+// All available ClientIdentifierTypes
+const (
+	ClientIdentifierIP            = ClientIdentifierType("ip")
+	ClientIdentifierRequestHeader = ClientIdentifierType("requestHeader")
+	ClientIdentifierRequestCookie = ClientIdentifierType("requestCookie")
+	ClientIdentifierPostParameter = ClientIdentifierType("postParameter")
+	ClientIdentifierSignalPayload = ClientIdentifierType("signalPayload")
+)
+
+// ClientIdentifier identifies a client for rate limiting.
+type ClientIdentifier struct {
+	Type ClientIdentifierType `json:"type"`
+	// Name is the header, cookie or parameter name.
+	Name string `json:"name,omitempty"`
+}
+
+// RateLimit is the rate limit configuration of a rule of type rateLimit.
+// A client is rate limited once the rule signal is seen Threshold times
+// within Interval minutes, and requests from it are then blocked with the
+// site's BlockHTTPCode for Duration seconds.
+type RateLimit struct {
+	Threshold         int                `json:"threshold"`
+	Interval          int                `json:"interval"`
+	Duration          int                `json:"duration"`
+	ClientIdentifiers []ClientIdentifier `json:"clientIdentifiers"`
+}
+
+// Validate checks the rate limit for errors the API would reject.
+func (rl RateLimit) Validate() error {
+	if rl.Threshold <= 0 {
+		return errors.New("rate limit threshold must be positive")
+	}
+	if rl.Interval <= 0 {
+		return errors.New("rate limit interval must be positive")
+	}
+	if rl.Duration <= 0 {
+		return errors.New("rate limit duration must be positive")
+	}
+
+	for i, id := range rl.ClientIdentifiers {
+		switch id.Type {
+		case ClientIdentifierIP, ClientIdentifierSignalPayload:
+		case ClientIdentifierRequestHeader, ClientIdentifierRequestCookie, ClientIdentifierPostParameter:
+			if id.Name == "" {
+				return fmt.Errorf("client identifier %d: %s requires a name", i, id.Type)
+			}
+		default:
+			return fmt.Errorf("client identifier %d: invalid type %q", i, id.Type)
+		}
+	}
+
+	return nil
+}
+
+// NewRateLimitRule returns the body for a rate limit rule on site that
+// counts signal and blocks it once rl is exceeded. If rl.Duration is zero,
+// the site's BlockDurationSeconds is used, and if rl has no client
+// identifiers, clients are identified by IP.
+func NewRateLimitRule(site Site, signal string, rl RateLimit, conditions []Condition) RuleBody {
+	if rl.Duration == 0 {
+		rl.Duration = site.BlockDurationSeconds
+	}
+	if len(rl.ClientIdentifiers) == 0 {
+		rl.ClientIdentifiers = []ClientIdentifier{{Type: ClientIdentifierIP}}
+	}
+
+	return RuleBody{
+		Type:          RuleTypeRateLimit,
+		GroupOperator: GroupOperatorAll,
+		Enabled:       true,
+		Signal:        signal,
+		Conditions:    conditions,
+		Actions:       []Action{{Type: ActionTypeBlockSignal, Signal: signal}},
+		RateLimit:     &rl,
+	}
+}
+
+// RuleBody is the body for creating or updating a rule.
+type RuleBody struct {
+	Type          RuleType      `json:"type"`
+	GroupOperator GroupOperator `json:"groupOperator"`
+	Enabled       bool          `json:"enabled"`
+	Reason        string        `json:"reason"`
+	// Signal is the signal a rule of type signal adds to requests, or
+	// the signal a rule of type rateLimit counts.
+	Signal string `json:"signal,omitempty"`
+	// Expiration is an RFC3339 timestamp after which the rule no longer
+	// applies, or empty for a rule that does not expire.
+	Expiration string      `json:"expiration"`
+	Conditions []Condition `json:"conditions"`
+	Actions    []Action    `json:"actions"`
+	// RateLimit is required for rules of type rateLimit.
+	RateLimit *RateLimit `json:"rateLimit,omitempty"`
+}
+
+// Rule contains the data for a rule.
+type Rule struct {
+	ID            string
+	Type          RuleType
+	GroupOperator GroupOperator
+	Enabled       bool
+	Reason        string
+	Signal        string
+	Expiration    string
+	Conditions    []Condition
+	Actions       []Action
+	RateLimit     *RateLimit
+	CreatedBy     string
+	Created       time.Time
+	Updated       time.Time
+}
+
+// rulesResponse is the response for the list rules endpoint.
+type rulesResponse struct {
+	TotalCount int
+	Data       []Rule
+}
+
+// Validate checks the rule body for errors the API would reject.
+func (b RuleBody) Validate() error {
+	switch b.Type {
+	case RuleTypeRequest, RuleTypeTemplatedSignal:
+	case RuleTypeSignal:
+		if b.Signal == "" {
+			return errors.New("signal rule requires a signal")
+		}
+	case RuleTypeRateLimit:
+		if b.Signal == "" {
+			return errors.New("rate limit rule requires a signal")
+		}
+		if b.RateLimit == nil {
+			return errors.New("rate limit rule requires a rate limit")
+		}
+		if err := b.RateLimit.Validate(); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("invalid rule type %q", b.Type)
+	}
+
+	if err := validateGroupOperator(b.GroupOperator); err != nil {
+		return err
+	}
+
+	if b.Expiration != "" {
+		if _, err := time.Parse(time.RFC3339, b.Expiration); err != nil {
+			return fmt.Errorf("invalid rule expiration %q: must be RFC3339", b.Expiration)
+		}
+	}
+
+	// Rate limit rules without conditions count the signal on all
+	// requests.
+	if len(b.Conditions) == 0 && b.Type != RuleTypeRateLimit {
+		return errors.New("rule requires at least one condition")
+	}
+	for i, c := range b.Conditions {
+		if err := c.Validate(); err != nil {
+			return fmt.Errorf("condition %d: %v", i, err)
+		}
+	}
+
+	if b.Type == RuleTypeRequest && len(b.Actions) == 0 {
+		return errors.New("request rule requires at least one action")
+	}
+	for i, a := range b.Actions {
+		if err := a.Validate(); err != nil {
+			return fmt.Errorf("action %d: %v", i, err)
+		}
+	}
+
+	return nil
+}
+
+// Validate checks the condition and its nested conditions for errors.
+func (c Condition) Validate() error {
+	switch c.Type {
+	case ConditionTypeSingle:
+		if c.Field == "" {
+			return errors.New("single condition requires a field")
+		}
+		switch c.Operator {
+		case OperatorExists, OperatorDoesNotExist:
+		case OperatorEquals, OperatorDoesNotEqual, OperatorContains, OperatorDoesNotContain,
+			OperatorLike, OperatorNotLike, OperatorMatches, OperatorDoesNotMatch,
+			OperatorInList, OperatorNotInList, OperatorGreaterEqual, OperatorLesserEqual:
+			if c.Value == "" {
+				return fmt.Errorf("operator %q requires a value", c.Operator)
+			}
+		default:
+			return fmt.Errorf("invalid condition operator %q", c.Operator)
+		}
+		if len(c.Conditions) > 0 {
+			return errors.New("single condition cannot have nested conditions")
+		}
+	case ConditionTypeGroup, ConditionTypeMultival:
+		if err := validateGroupOperator(c.GroupOperator); err != nil {
+			return err
+		}
+		if c.Type == ConditionTypeMultival && c.Field == "" {
+			return errors.New("multival condition requires a field")
+		}
+		if len(c.Conditions) == 0 {
+			return fmt.Errorf("%s condition requires nested conditions", c.Type)
+		}
+		for i, nested := range c.Conditions {
+			if err := nested.Validate(); err != nil {
+				return fmt.Errorf("condition %d: %v", i, err)
+			}
+		}
+	default:
+		return fmt.Errorf("invalid condition type %q", c.Type)
+	}
+
+	return nil
+}
+
+// Validate checks the action for errors.
+func (a Action) Validate() error {
+	switch a.Type {
+	case ActionTypeBlock, ActionTypeAllow, ActionTypeLogRequest:
+	case ActionTypeAddSignal, ActionTypeExcludeSignal, ActionTypeBlockSignal:
+		if a.Signal == "" {
+			return fmt.Errorf("%s action requires a signal", a.Type)
+		}
+	default:
+		return fmt.Errorf("invalid action type %q", a.Type)
+	}
+
+	return nil
+}
+
+func validateGroupOperator(op GroupOperator) error {
+	switch op {
+	case GroupOperatorAll, GroupOperatorAny:
+		return nil
+	default:
+		return fmt.Errorf("invalid group operator %q", op)
+	}
+}
+
+// ListRules lists rules for a given site.
+func (sc *Client) ListRules(corpName, siteName string) ([]Rule, error) {
+	return sc.ListRulesContext(context.Background(), corpName, siteName)
+}
+
+// ListRulesContext is like ListRules but uses the given context.
+func (sc *Client) ListRulesContext(ctx context.Context, corpName, siteName string) ([]Rule, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/sites/%s/rules", corpName, siteName), "")
+	if err != nil {
+		return []Rule{}, err
+	}
+
+	var rr rulesResponse
+	err = json.Unmarshal(resp, &rr)
+	if err != nil {
+		return []Rule{}, err
+	}
+
+	return rr.Data, nil
+}
+
+// ListRateLimitRules lists the rules of type rateLimit for a given site.
+func (sc *Client) ListRateLimitRules(corpName, siteName string) ([]Rule, error) {
+	return sc.ListRateLimitRulesContext(context.Background(), corpName, siteName)
+}
+
+// ListRateLimitRulesContext is like ListRateLimitRules but uses the given context.
+func (sc *Client) ListRateLimitRulesContext(ctx context.Context, corpName, siteName string) ([]Rule, error) {
+	rules, err := sc.ListRulesContext(ctx, corpName, siteName)
+	if err != nil {
+		return []Rule{}, err
+	}
+
+	rateLimitRules := []Rule{}
+	for _, r := range rules {
+		if r.Type == RuleTypeRateLimit {
+			rateLimitRules = append(rateLimitRules, r)
+		}
+	}
+
+	return rateLimitRules, nil
+}
+
+// GetRule gets a rule by id.
+func (sc *Client) GetRule(corpName, siteName, id string) (Rule, error) {
+	return sc.GetRuleContext(context.Background(), corpName, siteName, id)
+}
+
+// GetRuleContext is like GetRule but uses the given context.
+func (sc *Client) GetRuleContext(ctx context.Context, corpName, siteName, id string) (Rule, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/sites/%s/rules/%s", corpName, siteName, id), "")
+	if err != nil {
+		return Rule{}, err
+	}
+
+	var r Rule
+	err = json.Unmarshal(resp, &r)
+	if err != nil {
+		return Rule{}, err
+	}
+
+	return r, nil
+}
+
+// CreateRule validates and creates a rule.
+func (sc *Client) CreateRule(corpName, siteName string, body RuleBody) (Rule, error) {
+	return sc.CreateRuleContext(context.Background(), corpName, siteName, body)
+}
+
+// CreateRuleContext is like CreateRule but uses the given context.
+func (sc *Client) CreateRuleContext(ctx context.Context, corpName, siteName string, body RuleBody) (Rule, error) {
+	if err := body.Validate(); err != nil {
+		return Rule{}, err
+	}
+
+	b, err := json.Marshal(body)
+	if err != nil {
+		return Rule{}, err
+	}
+
+	resp, err := sc.doRequest(ctx, "POST", fmt.Sprintf("/v0/corps/%s/sites/%s/rules", corpName, siteName), string(b))
+	if err != nil {
+		return Rule{}, err
+	}
+
+	var r Rule
+	err = json.Unmarshal(resp, &r)
+	if err != nil {
+		return Rule{}, err
+	}
+
+	return r, nil
+}
+
+// UpdateRule validates and replaces a rule by id.
+func (sc *Client) UpdateRule(corpName, siteName, id string, body RuleBody) (Rule, error) {
+	return sc.UpdateRuleContext(context.Background(), corpName, siteName, id, body)
+}
+
+// UpdateRuleContext is like UpdateRule but uses the given context.
+func (sc *Client) UpdateRuleContext(ctx context.Context, corpName, siteName, id string, body RuleBody) (Rule, error) {
+	if err := body.Validate(); err != nil {
+		return Rule{}, err
+	}
+
+	b, err := json.Marshal(body)
+	if err != nil {
+		return Rule{}, err
+	}
+
+	resp, err := sc.doRequest(ctx, "PUT", fmt.Sprintf("/v0/corps/%s/sites/%s/rules/%s", corpName, siteName, id), string(b))
+	if err != nil {
+		return Rule{}, err
+	}
+
+	var r Rule
+	err = json.Unmarshal(resp, &r)
+	if err != nil {
+		return Rule{}, err
+	}
+
+	return r, nil
+}
+
+// DeleteRule deletes a rule by id.
+func (sc *Client) DeleteRule(corpName, siteName, id string) error {
+	return sc.DeleteRuleContext(context.Background(), corpName, siteName, id)
+}
+
+// DeleteRuleContext is like DeleteRule but uses the given context.
+func (sc *Client) DeleteRuleContext(ctx context.Context, corpName, siteName, id string) error {
+	_, err := sc.doRequest(ctx, "DELETE", fmt.Sprintf("/v0/corps/%s/sites/%s/rules/%s", corpName, siteName, id), "")
+
+	return err
+}
+
+// CorpRuleScope selects the sites a corp rule applies to.
+type CorpRuleScope string
+
+// All available CorpRuleScopes
+const (
+	CorpRuleScopeGlobal        = CorpRuleScope("global")
+	CorpRuleScopeSpecificSites = CorpRuleScope("specificSites")
+)
+
+// CorpRuleBody is the body for creating or updating a corp rule.
+type CorpRuleBody struct {
+	RuleBody
+	CorpScope CorpRuleScope `json:"corpScope"`
+	// SiteNames are the sites a rule with scope specificSites applies to.
+	SiteNames []string `json:"siteNames"`
+}
+
+// CorpRule contains the data for a corp rule.
+type CorpRule struct {
+	Rule
+	CorpScope CorpRuleScope
+	// SiteNames are the sites the rule currently applies to.
+	SiteNames []string
+}
+
+// corpRulesResponse is the response for the list corp rules endpoint.
+type corpRulesResponse struct {
+	TotalCount int
+	Data       []CorpRule
+}
+
+// Validate checks the corp rule body for errors the API would reject.
+func (b CorpRuleBody) Validate() error {
+	if err := b.RuleBody.Validate(); err != nil {
+		return err
+	}
+
+	switch b.CorpScope {
+	case CorpRuleScopeGlobal:
+		if len(b.SiteNames) > 0 {
+			return errors.New("global corp rule cannot have site names")
+		}
+	case CorpRuleScopeSpecificSites:
+		if len(b.SiteNames) == 0 {
+			return errors.New("corp rule with scope specificSites requires site names")
+		}
+	default:
+		return fmt.Errorf("invalid corp rule scope %q", b.CorpScope)
+	}
+
+	return nil
+}
+
+// ListCorpRules lists rules for a given corp.
+func (sc *Client) ListCorpRules(corpName string) ([]CorpRule, error) {
+	return sc.ListCorpRulesContext(context.Background(), corpName)
+}
+
+// ListCorpRulesContext is like ListCorpRules but uses the given context.
+func (sc *Client) ListCorpRulesContext(ctx context.Context, corpName string) ([]CorpRule, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/rules", corpName), "")
+	if err != nil {
+		return []CorpRule{}, err
+	}
+
+	var cr corpRulesResponse
+	err = json.Unmarshal(resp, &cr)
+	if err != nil {
+		return []CorpRule{}, err
+	}
+
+	return cr.Data, nil
+}
+
+// GetCorpRule gets a corp rule by id.
+func (sc *Client) GetCorpRule(corpName, id string) (CorpRule, error) {
+	return sc.GetCorpRuleContext(context.Background(), corpName, id)
+}
+
+// GetCorpRuleContext is like GetCorpRule but uses the given context.
+func (sc *Client) GetCorpRuleContext(ctx context.Context, corpName, id string) (CorpRule, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/rules/%s", corpName, id), "")
+	if err != nil {
+		return CorpRule{}, err
+	}
+
+	var r CorpRule
+	err = json.Unmarshal(resp, &r)
+	if err != nil {
+		return CorpRule{}, err
+	}
+
+	return r, nil
+}
+
+// CreateCorpRule validates and creates a corp rule.
+func (sc *Client) CreateCorpRule(corpName string, body CorpRuleBody) (CorpRule, error) {
+	return sc.CreateCorpRuleContext(context.Background(), corpName, body)
+}
+
+// CreateCorpRuleContext is like CreateCorpRule but uses the given context.
+func (sc *Client) CreateCorpRuleContext(ctx context.Context, corpName string, body CorpRuleBody) (CorpRule, error) {
+	if err := body.Validate(); err != nil {
+		return CorpRule{}, err
+	}
+
+	b, err := json.Marshal(body)
+	if err != nil {
+		return CorpRule{}, err
+	}
+
+	resp, err := sc.doRequest(ctx, "POST", fmt.Sprintf("/v0/corps/%s/rules", corpName), string(b))
+	if err != nil {
+		return CorpRule{}, err
+	}
+
+	var r CorpRule
+	err = json.Unmarshal(resp, &r)
+	if err != nil {
+		return CorpRule{}, err
+	}
+
+	return r, nil
+}
+
+// UpdateCorpRule validates and replaces a corp rule by id.
+func (sc *Client) UpdateCorpRule(corpName, id string, body CorpRuleBody) (CorpRule, error) {
+	return sc.UpdateCorpRuleContext(context.Background(), corpName, id, body)
+}
+
+// UpdateCorpRuleContext is like UpdateCorpRule but uses the given context.
+func (sc *Client) UpdateCorpRuleContext(ctx context.Context, corpName, id string, body CorpRuleBody) (CorpRule, error) {
+	if err := body.Validate(); err != nil {
+		return CorpRule{}, err
+	}
+
+	b, err := json.Marshal(body)
+	if err != nil {
+		return CorpRule{}, err
+	}
+
+	resp, err := sc.doRequest(ctx, "PUT", fmt.Sprintf("/v0/corps/%s/rules/%s", corpName, id), string(b))
+	if err != nil {
+		return CorpRule{}, err
+	}
+
+	var r CorpRule
+	err = json.Unmarshal(resp, &r)
+	if err != nil {
+		return CorpRule{}, err
+	}
+
+	return r, nil
+}
+
+// DeleteCorpRule deletes a corp rule by id.
+func (sc *Client) DeleteCorpRule(corpName, id string) error {
+	return sc.DeleteCorpRuleContext(context.Background(), corpName, id)
+}
+
+// DeleteCorpRuleContext is like DeleteCorpRule but uses the given context.
+func (sc *Client) DeleteCorpRuleContext(ctx context.Context, corpName, id string) error {
+	_, err := sc.doRequest(ctx, "DELETE", fmt.Sprintf("/v0/corps/%s/rules/%s", corpName, id), "")
+
+	return err
+}
@@ -0,0 +1,145 @@
+package sigsci
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRuleBodyValidate(t *testing.T) {
+	valid := RuleBody{
+		Type:          RuleTypeRequest,
+		GroupOperator: GroupOperatorAll,
+		Enabled:       true,
+		Reason:        "block scanners",
+		Conditions: []Condition{
+			{Type: ConditionTypeSingle, Field: "path", Operator: OperatorEquals, Value: "/login"},
+			{
+				Type:          ConditionTypeGroup,
+				GroupOperator: GroupOperatorAny,
+				Conditions: []Condition{
+					{Type: ConditionTypeSingle, Field: "userAgent", Operator: OperatorContains, Value: "sqlmap"},
+					{Type: ConditionTypeSingle, Field: "ip", Operator: OperatorInList, Value: "corp.scanners"},
+				},
+			},
+		},
+		Actions: []Action{{Type: ActionTypeBlock}},
+	}
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("valid rule: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*RuleBody)
+	}{
+		{"no conditions", func(b *RuleBody) { b.Conditions = nil }},
+		{"no actions", func(b *RuleBody) { b.Actions = nil }},
+		{"bad group operator", func(b *RuleBody) { b.GroupOperator = "some" }},
+		{"bad expiration", func(b *RuleBody) { b.Expiration = "tomorrow" }},
+		{"signal rule without signal", func(b *RuleBody) { b.Type = RuleTypeSignal }},
+		{"addSignal without signal", func(b *RuleBody) { b.Actions = []Action{{Type: ActionTypeAddSignal}} }},
+		{"missing value", func(b *RuleBody) {
+			b.Conditions = []Condition{{Type: ConditionTypeSingle, Field: "path", Operator: OperatorEquals}}
+		}},
+		{"empty group", func(b *RuleBody) {
+			b.Conditions = []Condition{{Type: ConditionTypeGroup, GroupOperator: GroupOperatorAll}}
+		}},
+		{"nested invalid", func(b *RuleBody) {
+			b.Conditions = []Condition{{
+				Type:          ConditionTypeGroup,
+				GroupOperator: GroupOperatorAll,
+				Conditions:    []Condition{{Type: ConditionTypeSingle, Operator: OperatorExists}},
+			}}
+		}},
+	}
+
+	for _, tt := range tests {
+		b := valid
+		tt.modify(&b)
+		if err := b.Validate(); err == nil {
+			t.Errorf("%s: expected validation error", tt.name)
+		}
+	}
+}
+
+func TestCreateRuleValidates(t *testing.T) {
+	sc := NewTokenClient("test@test.net", "token", WithBaseURL("http://127.0.0.1:0"))
+	_, err := sc.CreateRule("testcorp", "www.mysite.com", RuleBody{Type: RuleTypeRequest})
+	if err == nil {
+		t.Fatal("expected validation error")
+	}
+	if _, ok := err.(*APIError); ok {
+		t.Errorf("got API error %v, want validation error before the request", err)
+	}
+}
+
+func TestCorpRuleBodyValidate(t *testing.T) {
+	rule := RuleBody{
+		Type:          RuleTypeRequest,
+		GroupOperator: GroupOperatorAll,
+		Conditions:    []Condition{{Type: ConditionTypeSingle, Field: "country", Operator: OperatorEquals, Value: "KP"}},
+		Actions:       []Action{{Type: ActionTypeBlock}},
+	}
+
+	tests := []struct {
+		body    CorpRuleBody
+		wantErr bool
+	}{
+		{CorpRuleBody{RuleBody: rule, CorpScope: CorpRuleScopeGlobal}, false},
+		{CorpRuleBody{RuleBody: rule, CorpScope: CorpRuleScopeSpecificSites, SiteNames: []string{"www.mysite.com"}}, false},
+		{CorpRuleBody{RuleBody: rule, CorpScope: CorpRuleScopeSpecificSites}, true},
+		{CorpRuleBody{RuleBody: rule, CorpScope: CorpRuleScopeGlobal, SiteNames: []string{"www.mysite.com"}}, true},
+		{CorpRuleBody{RuleBody: rule}, true},
+	}
+
+	for i, tt := range tests {
+		if err := tt.body.Validate(); (err != nil) != tt.wantErr {
+			t.Errorf("%d: got error %v, want error %v", i, err, tt.wantErr)
+		}
+	}
+}
+
+func TestNewRateLimitRule(t *testing.T) {
+	site := Site{Name: "www.mysite.com", BlockDurationSeconds: 3600, BlockHTTPCode: 406}
+	b := NewRateLimitRule(site, "site.login-attempt", RateLimit{Threshold: 10, Interval: 1}, nil)
+
+	if err := b.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if b.RateLimit.Duration != 3600 {
+		t.Errorf("got duration %d, want site block duration 3600", b.RateLimit.Duration)
+	}
+	if len(b.RateLimit.ClientIdentifiers) != 1 || b.RateLimit.ClientIdentifiers[0].Type != ClientIdentifierIP {
+		t.Errorf("got client identifiers %+v, want ip", b.RateLimit.ClientIdentifiers)
+	}
+
+	b.RateLimit.ClientIdentifiers = []ClientIdentifier{{Type: ClientIdentifierRequestHeader}}
+	if err := b.Validate(); err == nil {
+		t.Error("expected error for header identifier without name")
+	}
+}
+
+func TestListRateLimitRules(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"totalCount":2,"data":[
+			{"id":"1","type":"request","actions":[{"type":"block"}]},
+			{"id":"2","type":"rateLimit","signal":"site.login-attempt","rateLimit":{"threshold":10,"interval":1,"duration":600,"clientIdentifiers":[{"type":"requestHeader","name":"X-Client"}]}}
+		]}`))
+	}))
+	defer ts.Close()
+
+	sc := NewTokenClient("test@test.net", "token", WithBaseURL(ts.URL))
+	rules, err := sc.ListRateLimitRules("testcorp", "www.mysite.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rules) != 1 || rules[0].ID != "2" {
+		t.Fatalf("got rules %+v, want rule 2 only", rules)
+	}
+
+	rl := rules[0].RateLimit
+	if rl == nil || rl.Threshold != 10 || rl.Duration != 600 || rl.ClientIdentifiers[0].Name != "X-Client" {
+		t.Errorf("unexpected rate limit %+v", rl)
+	}
+}
@@ -0,0 +1,253 @@
+package sigsci
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Signal contains the data for a signal, also known as a tag. Custom
+// signals can be referenced by rules, custom alerts and request tags.
+type Signal struct {
+	// TagName is the full name of the signal, e.g. "site.my-signal" or
+	// "corp.my-signal" for custom signals.
+	TagName       string
+	ShortName     string
+	LongName      string
+	Description   string
+	Configurable  bool
+	Informative   bool
+	NeedsResponse bool
+	CreatedBy     string
+	Created       time.Time
+}
+
+// signalsResponse is the response for the list signals endpoints.
+type signalsResponse struct {
+	Data []Signal
+}
+
+// CreateSignalBody is the body for creating a custom signal. The tag name
+// of the signal is derived from ShortName.
+type CreateSignalBody struct {
+	ShortName   string `json:"shortName"`
+	Description string `json:"description"`
+}
+
+// UpdateSignalBody is the body for updating a custom signal.
+type UpdateSignalBody struct {
+	Description string `json:"description"`
+}
+
+// ListSiteSignals lists custom signals for a given site.
+func (sc *Client) ListSiteSignals(corpName, siteName string) ([]Signal, error) {
+	return sc.ListSiteSignalsContext(context.Background(), corpName, siteName)
+}
+
+// ListSiteSignalsContext is like ListSiteSignals but uses the given context.
+func (sc *Client) ListSiteSignalsContext(ctx context.Context, corpName, siteName string) ([]Signal, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/sites/%s/tags", corpName, siteName), "")
+	if err != nil {
+		return []Signal{}, err
+	}
+
+	var sr signalsResponse
+	err = json.Unmarshal(resp, &sr)
+	if err != nil {
+		return []Signal{}, err
+	}
+
+	return sr.Data, nil
+}
+
+// CreateSiteSignal creates a custom site signal.
+func (sc *Client) CreateSiteSignal(corpName, siteName string, body CreateSignalBody) (Signal, error) {
+	return sc.CreateSiteSignalContext(context.Background(), corpName, siteName, body)
+}
+
+// CreateSiteSignalContext is like CreateSiteSignal but uses the given context.
+func (sc *Client) CreateSiteSignalContext(ctx context.Context, corpName, siteName string, body CreateSignalBody) (Signal, error) {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return Signal{}, err
+	}
+
+	resp, err := sc.doRequest(ctx, "POST", fmt.Sprintf("/v0/corps/%s/sites/%s/tags", corpName, siteName), string(b))
+	if err != nil {
+		return Signal{}, err
+	}
+
+	var s Signal
+	err = json.Unmarshal(resp, &s)
+	if err != nil {
+		return Signal{}, err
+	}
+
+	return s, nil
+}
+
+// GetSiteSignal gets a custom site signal by tag name.
+func (sc *Client) GetSiteSignal(corpName, siteName, tagName string) (Signal, error) {
+	return sc.GetSiteSignalContext(context.Background(), corpName, siteName, tagName)
+}
+
+// GetSiteSignalContext is like GetSiteSignal but uses the given context.
+func (sc *Client) GetSiteSignalContext(ctx context.Context, corpName, siteName, tagName string) (Signal, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/sites/%s/tags/%s", corpName, siteName, tagName), "")
+	if err != nil {
+		return Signal{}, err
+	}
+
+	var s Signal
+	err = json.Unmarshal(resp, &s)
+	if err != nil {
+		return Signal{}, err
+	}
+
+	return s, nil
+}
+
+// UpdateSiteSignal updates a custom site signal by tag name.
+func (sc *Client) UpdateSiteSignal(corpName, siteName, tagName string, body UpdateSignalBody) (Signal, error) {
+	return sc.UpdateSiteSignalContext(context.Background(), corpName, siteName, tagName, body)
+}
+
+// UpdateSiteSignalContext is like UpdateSiteSignal but uses the given context.
+func (sc *Client) UpdateSiteSignalContext(ctx context.Context, corpName, siteName, tagName string, body UpdateSignalBody) (Signal, error) {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return Signal{}, err
+	}
+
+	resp, err := sc.doRequest(ctx, "PATCH", fmt.Sprintf("/v0/corps/%s/sites/%s/tags/%s", corpName, siteName, tagName), string(b))
+	if err != nil {
+		return Signal{}, err
+	}
+
+	var s Signal
+	err = json.Unmarshal(resp, &s)
+	if err != nil {
+		return Signal{}, err
+	}
+
+	return s, nil
+}
+
+// DeleteSiteSignal deletes a custom site signal by tag name.
+func (sc *Client) DeleteSiteSignal(corpName, siteName, tagName string) error {
+	return sc.DeleteSiteSignalContext(context.Background(), corpName, siteName, tagName)
+}
+
+// DeleteSiteSignalContext is like DeleteSiteSignal but uses the given context.
+func (sc *Client) DeleteSiteSignalContext(ctx context.Context, corpName, siteName, tagName string) error {
+	_, err := sc.doRequest(ctx, "DELETE", fmt.Sprintf("/v0/corps/%s/sites/%s/tags/%s", corpName, siteName, tagName), "")
+
+	return err
+}
+
+// ListCorpSignals lists custom signals for a given corp.
+func (sc *Client) ListCorpSignals(corpName string) ([]Signal, error) {
+	return sc.ListCorpSignalsContext(context.Background(), corpName)
+}
+
+// ListCorpSignalsContext is like ListCorpSignals but uses the given context.
+func (sc *Client) ListCorpSignalsContext(ctx context.Context, corpName string) ([]Signal, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/tags", corpName), "")
+	if err != nil {
+		return []Signal{}, err
+	}
+
+	var sr signalsResponse
+	err = json.Unmarshal(resp, &sr)
+	if err != nil {
+		return []Signal{}, err
+	}
+
+	return sr.Data, nil
+}
+
+// CreateCorpSignal creates a custom corp signal.
+func (sc *Client) CreateCorpSignal(corpName string, body CreateSignalBody) (Signal, error) {
+	return sc.CreateCorpSignalContext(context.Background(), corpName, body)
+}
+
+// CreateCorpSignalContext is like CreateCorpSignal but uses the given context.
+func (sc *Client) CreateCorpSignalContext(ctx context.Context, corpName string, body CreateSignalBody) (Signal, error) {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return Signal{}, err
+	}
+
+	resp, err := sc.doRequest(ctx, "POST", fmt.Sprintf("/v0/corps/%s/tags", corpName), string(b))
+	if err != nil {
+		return Signal{}, err
+	}
+
+	var s Signal
+	err = json.Unmarshal(resp, &s)
+	if err != nil {
+		return Signal{}, err
+	}
+
+	return s, nil
+}
+
+// GetCorpSignal gets a custom corp signal by tag name.
+func (sc *Client) GetCorpSignal(corpName, tagName string) (Signal, error) {
+	return sc.GetCorpSignalContext(context.Background(), corpName, tagName)
+}
+
+// GetCorpSignalContext is like GetCorpSignal but uses the given context.
+func (sc *Client) GetCorpSignalContext(ctx context.Context, corpName, tagName string) (Signal, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/tags/%s", corpName, tagName), "")
+	if err != nil {
+		return Signal{}, err
+	}
+
+	var s Signal
+	err = json.Unmarshal(resp, &s)
+	if err != nil {
+		return Signal{}, err
+	}
+
+	return s, nil
+}
+
+// UpdateCorpSignal updates a custom corp signal by tag name.
+func (sc *Client) UpdateCorpSignal(corpName, tagName string, body UpdateSignalBody) (Signal, error) {
+	return sc.UpdateCorpSignalContext(context.Background(), corpName, tagName, body)
+}
+
+// UpdateCorpSignalContext is like UpdateCorpSignal but uses the given context.
+func (sc *Client) UpdateCorpSignalContext(ctx context.Context, corpName, tagName string, body UpdateSignalBody) (Signal, error) {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return Signal{}, err
+	}
+
+	resp, err := sc.doRequest(ctx, "PATCH", fmt.Sprintf("/v0/corps/%s/tags/%s", corpName, tagName), string(b))
+	if err != nil {
+		return Signal{}, err
+	}
+
+	var s Signal
+	err = json.Unmarshal(resp, &s)
+	if err != nil {
+		return Signal{}, err
+	}
+
+	return s, nil
+}
+
+// DeleteCorpSignal deletes a custom corp signal by tag name.
+func (sc *Client) DeleteCorpSignal(corpName, tagName string) error {
+	return sc.DeleteCorpSignalContext(context.Background(), corpName, tagName)
+}
+
+// DeleteCorpSignalContext is like DeleteCorpSignal but uses the given context.
+func (sc *Client) DeleteCorpSignalContext(ctx context.Context, corpName, tagName string) error {
+	_, err := sc.doRequest(ctx, "DELETE", fmt.Sprintf("/v0/corps/%s/tags/%s", corpName, tagName), "")
+
+	return err
+}
@@ -0,0 +1,111 @@
+package sigsci
+
+import "testing"
+
+func TestSignals(t *testing.T) {
+	signal := Signal{TagName: "site.my-signal", ShortName: "my-signal", Description: "new", Configurable: true}
+	response := `{"tagName":"site.my-signal","shortName":"my-signal","description":"new","configurable":true}`
+
+	runRequestTests(t, []requestTest{
+		{
+			name: "list site",
+			call: func(sc *Client) (interface{}, error) {
+				return sc.ListSiteSignals("testcorp", "www.mysite.com")
+			},
+			method:   "GET",
+			path:     "/v0/corps/testcorp/sites/www.mysite.com/tags",
+			response: `{"data":[` + response + `]}`,
+			want:     []Signal{signal},
+		},
+		{
+			name: "create site",
+			call: func(sc *Client) (interface{}, error) {
+				return sc.CreateSiteSignal("testcorp", "www.mysite.com", CreateSignalBody{ShortName: "my-signal", Description: "new"})
+			},
+			method:   "POST",
+			path:     "/v0/corps/testcorp/sites/www.mysite.com/tags",
+			body:     `{"shortName":"my-signal","description":"new"}`,
+			response: response,
+			want:     signal,
+		},
+		{
+			name: "get site",
+			call: func(sc *Client) (interface{}, error) {
+				return sc.GetSiteSignal("testcorp", "www.mysite.com", "site.my-signal")
+			},
+			method:   "GET",
+			path:     "/v0/corps/testcorp/sites/www.mysite.com/tags/site.my-signal",
+			response: response,
+			want:     signal,
+		},
+		{
+			name: "update site",
+			call: func(sc *Client) (interface{}, error) {
+				return sc.UpdateSiteSignal("testcorp", "www.mysite.com", "site.my-signal", UpdateSignalBody{Description: "new"})
+			},
+			method:   "PATCH",
+			path:     "/v0/corps/testcorp/sites/www.mysite.com/tags/site.my-signal",
+			body:     `{"description":"new"}`,
+			response: response,
+			want:     signal,
+		},
+		{
+			name: "delete site",
+			call: func(sc *Client) (interface{}, error) {
+				return nil, sc.DeleteSiteSignal("testcorp", "www.mysite.com", "site.my-signal")
+			},
+			method: "DELETE",
+			path:   "/v0/corps/testcorp/sites/www.mysite.com/tags/site.my-signal",
+		},
+		{
+			name: "list corp",
+			call: func(sc *Client) (interface{}, error) {
+				return sc.ListCorpSignals("testcorp")
+			},
+			method:   "GET",
+			path:     "/v0/corps/testcorp/tags",
+			response: `{"data":[` + response + `]}`,
+			want:     []Signal{signal},
+		},
+		{
+			name: "create corp",
+			call: func(sc *Client) (interface{}, error) {
+				return sc.CreateCorpSignal("testcorp", CreateSignalBody{ShortName: "my-signal", Description: "new"})
+			},
+			method:   "POST",
+			path:     "/v0/corps/testcorp/tags",
+			body:     `{"shortName":"my-signal","description":"new"}`,
+			response: response,
+			want:     signal,
+		},
+		{
+			name: "get corp",
+			call: func(sc *Client) (interface{}, error) {
+				return sc.GetCorpSignal("testcorp", "corp.my-signal")
+			},
+			method:   "GET",
+			path:     "/v0/corps/testcorp/tags/corp.my-signal",
+			response: response,
+			want:     signal,
+		},
+		{
+			name: "update corp",
+			call: func(sc *Client) (interface{}, error) {
+				return sc.UpdateCorpSignal("testcorp", "corp.my-signal", UpdateSignalBody{Description: "new"})
+			},
+			method:   "PATCH",
+			path:     "/v0/corps/testcorp/tags/corp.my-signal",
+			body:     `{"description":"new"}`,
+			response: response,
+			want:     signal,
+		},
+		{
+			name: "delete corp",
+			call: func(sc *Client) (interface{}, error) {
+				return nil, sc.DeleteCorpSignal("testcorp", "corp.my-signal")
+			},
+			method: "DELETE",
+			path:   "/v0/corps/testcorp/tags/corp.my-signal",
+		},
+	})
+}
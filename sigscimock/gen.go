@@ -0,0 +1,192 @@
+//go:build ignore
+// +build ignore
+
+// gen generates mock.go from the interfaces in ../interfaces.go. Run it
+// with go generate.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"strings"
+)
+
+type method struct {
+	name    string
+	params  []param // without the leading context
+	results []string
+}
+
+type param struct {
+	name string
+	typ  string
+}
+
+func main() {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "../interfaces.go", nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var methods []method
+	imports := map[string]bool{"context": true, "sync": true}
+	ast.Inspect(f, func(n ast.Node) bool {
+		ts, ok := n.(*ast.TypeSpec)
+		if !ok {
+			return true
+		}
+		it, ok := ts.Type.(*ast.InterfaceType)
+		if !ok {
+			return false
+		}
+		for _, field := range it.Methods.List {
+			if len(field.Names) == 0 {
+				continue // embedded interface
+			}
+			name := field.Names[0].Name
+			if !strings.HasSuffix(name, "Context") {
+				continue
+			}
+			ft := field.Type.(*ast.FuncType)
+			m := method{name: strings.TrimSuffix(name, "Context")}
+			for i, p := range ft.Params.List {
+				typ := typeString(p.Type, imports)
+				for j, n := range p.Names {
+					if i == 0 && j == 0 {
+						continue // ctx
+					}
+					m.params = append(m.params, param{name: n.Name, typ: typ})
+				}
+			}
+			for _, r := range ft.Results.List {
+				typ := typeString(r.Type, imports)
+				n := len(r.Names)
+				if n == 0 {
+					n = 1
+				}
+				for i := 0; i < n; i++ {
+					m.results = append(m.results, typ)
+				}
+			}
+			methods = append(methods, m)
+		}
+		return false
+	})
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gen.go; DO NOT EDIT.\n\npackage sigscimock\n\nimport (\n")
+	for _, imp := range []string{"context", "net/url", "sync"} {
+		if imports[imp] {
+			fmt.Fprintf(&buf, "%q\n", imp)
+		}
+	}
+	buf.WriteString("\nsigsci \"github.com/signalsciences/go-sigsci\"\n)\n\n")
+
+	buf.WriteString("// Client is a mock implementation of sigsci.API. Each method calls the\n")
+	buf.WriteString("// function in the matching Func field, or returns zero values if it is\n")
+	buf.WriteString("// nil, and records the call. A method and its Context variant share a\n")
+	buf.WriteString("// Func field, which is passed the context.\n")
+	buf.WriteString("type Client struct {\n")
+	for _, m := range methods {
+		fmt.Fprintf(&buf, "%sFunc func(%s) (%s)\n", m.name, m.funcParams(), strings.Join(m.results, ", "))
+	}
+	buf.WriteString("\nmu sync.Mutex\ncalls []Call\n}\n\n")
+	buf.WriteString("var _ sigsci.API = (*Client)(nil)\n")
+
+	for _, m := range methods {
+		fmt.Fprintf(&buf, "\n// %s implements sigsci.API.\n", m.name)
+		fmt.Fprintf(&buf, "func (m *Client) %s(%s) (%s) {\n", m.name, m.params0(), strings.Join(m.results, ", "))
+		fmt.Fprintf(&buf, "return m.%sContext(%s)\n}\n", m.name, m.args("context.Background()"))
+
+		fmt.Fprintf(&buf, "\n// %sContext implements sigsci.API.\n", m.name)
+		fmt.Fprintf(&buf, "func (m *Client) %sContext(%s) (%s) {\n", m.name, m.funcParams(), m.namedResults())
+		fmt.Fprintf(&buf, "m.record(%q%s)\n", m.name, m.args(""))
+		fmt.Fprintf(&buf, "if m.%sFunc != nil {\n", m.name)
+		fmt.Fprintf(&buf, "return m.%sFunc(%s)\n}\n\nreturn\n}\n", m.name, m.args("ctx"))
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("%v\n%s", err, buf.Bytes())
+	}
+	if err := ioutil.WriteFile("mock.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// params0 returns the parameter list without the context.
+func (m method) params0() string {
+	var s []string
+	for _, p := range m.params {
+		s = append(s, p.name+" "+p.typ)
+	}
+	return strings.Join(s, ", ")
+}
+
+func (m method) funcParams() string {
+	if len(m.params) == 0 {
+		return "ctx context.Context"
+	}
+	return "ctx context.Context, " + m.params0()
+}
+
+// args returns the argument list, prefixed with first if it is not empty,
+// or with a comma otherwise.
+func (m method) args(first string) string {
+	var s []string
+	if first != "" {
+		s = append(s, first)
+	}
+	for _, p := range m.params {
+		s = append(s, p.name)
+	}
+	if first == "" && len(s) > 0 {
+		return ", " + strings.Join(s, ", ")
+	}
+	return strings.Join(s, ", ")
+}
+
+func (m method) namedResults() string {
+	var s []string
+	for i, r := range m.results {
+		if i == len(m.results)-1 && r == "error" {
+			s = append(s, "err error")
+			continue
+		}
+		s = append(s, fmt.Sprintf("r%d %s", i, r))
+	}
+	return strings.Join(s, ", ")
+}
+
+// typeString returns the source for a type in ../interfaces.go, qualifying
+// the types of the sigsci package and adding the imports it needs.
+func typeString(e ast.Expr, imports map[string]bool) string {
+	switch t := e.(type) {
+	case *ast.Ident:
+		if ast.IsExported(t.Name) {
+			return "sigsci." + t.Name
+		}
+		return t.Name
+	case *ast.SelectorExpr:
+		pkg := t.X.(*ast.Ident).Name
+		if pkg == "url" {
+			imports["net/url"] = true
+		}
+		return pkg + "." + t.Sel.Name
+	case *ast.StarExpr:
+		return "*" + typeString(t.X, imports)
+	case *ast.ArrayType:
+		return "[]" + typeString(t.Elt, imports)
+	case *ast.MapType:
+		return "map[" + typeString(t.Key, imports) + "]" + typeString(t.Value, imports)
+	}
+	log.Fatalf("unsupported type %T", e)
+	return ""
+}
@@ -0,0 +1,2007 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package sigscimock
+
+import (
+	"context"
+	"net/url"
+	"sync"
+
+	sigsci "github.com/signalsciences/go-sigsci"
+)
+
+// Client is a mock implementation of sigsci.API. Each method calls the
+// function in the matching Func field, or returns zero values if it is
+// nil, and records the call. A method and its Context variant share a
+// Func field, which is passed the context.
+type Client struct {
+	LogoutFunc                 func(ctx context.Context) error
+	ListCorpsFunc              func(ctx context.Context) ([]sigsci.Corp, error)
+	GetCorpFunc                func(ctx context.Context, corpName string) (sigsci.Corp, error)
+	UpdateCorpFunc             func(ctx context.Context, corpName string, body sigsci.UpdateCorpBody) (sigsci.Corp, error)
+	ListCorpActivityFunc       func(ctx context.Context, corpName string, limit int, page int) ([]sigsci.ActivityEvent, error)
+	ListCorpUsersFunc          func(ctx context.Context, corpName string) ([]sigsci.CorpUser, error)
+	GetCorpUserFunc            func(ctx context.Context, corpName string, email string) (sigsci.CorpUser, error)
+	InviteUserFunc             func(ctx context.Context, corpName string, email string, invite sigsci.CorpUserInvite) (sigsci.CorpUser, error)
+	UpdateCorpUserFunc         func(ctx context.Context, corpName string, email string, body sigsci.CorpUserInvite) (sigsci.CorpUser, error)
+	DeleteCorpUserFunc         func(ctx context.Context, corpName string, email string) error
+	ListSiteMembersFunc        func(ctx context.Context, corpName string, siteName string) ([]sigsci.SiteMember, error)
+	GetSiteMemberFunc          func(ctx context.Context, corpName string, siteName string, email string) (sigsci.SiteMember, error)
+	AddSiteMemberFunc          func(ctx context.Context, corpName string, siteName string, email string) (sigsci.SiteMemberResponse, error)
+	AddSiteMembersFunc         func(ctx context.Context, corpName string, siteName string, body sigsci.SiteMembersBody) ([]sigsci.SiteMember, error)
+	InviteSiteMemberFunc       func(ctx context.Context, corpName string, siteName string, email string, body sigsci.SiteMemberBody) (sigsci.SiteMemberResponse, error)
+	SetSiteMemberRoleFunc      func(ctx context.Context, corpName string, siteName string, email string, role sigsci.Role) (sigsci.SiteMemberResponse, error)
+	DeleteSiteMemberFunc       func(ctx context.Context, corpName string, siteName string, email string) error
+	ListAPITokensFunc          func(ctx context.Context) ([]sigsci.APIToken, error)
+	CreateAPITokenFunc         func(ctx context.Context, body sigsci.CreateAPITokenBody) (sigsci.APIToken, error)
+	GetAPITokenFunc            func(ctx context.Context, id string) (sigsci.APIToken, error)
+	DeleteAPITokenFunc         func(ctx context.Context, id string) error
+	ListCorpUserAPITokensFunc  func(ctx context.Context, corpName string, email string) ([]sigsci.APIToken, error)
+	DeleteCorpUserAPITokenFunc func(ctx context.Context, corpName string, email string, id string) error
+	RotateTokenFunc            func(ctx context.Context, email string, name string, oldID string) (sigsci.APIToken, error)
+	ListSitesFunc              func(ctx context.Context, corpName string) ([]sigsci.Site, error)
+	GetSiteFunc                func(ctx context.Context, corpName string, siteName string) (sigsci.Site, error)
+	CreateSiteFunc             func(ctx context.Context, corpName string, body sigsci.CreateSiteBody) (sigsci.Site, error)
+	UpdateSiteFunc             func(ctx context.Context, corpName string, siteName string, body sigsci.UpdateSiteBody) (sigsci.Site, error)
+	DeleteSiteFunc             func(ctx context.Context, corpName string, siteName string) error
+	ListSiteActivityFunc       func(ctx context.Context, corpName string, siteName string, limit int, page int) ([]sigsci.ActivityEvent, error)
+	GetSiteMonitorFunc         func(ctx context.Context, corpName string, siteName string, email string) (sigsci.SiteMonitor, error)
+	GenerateSiteMonitorFunc    func(ctx context.Context, corpName string, siteName string) (sigsci.SiteMonitor, error)
+	EnableSiteMonitorFunc      func(ctx context.Context, corpName string, siteName string) error
+	DisableSiteMonitorFunc     func(ctx context.Context, corpName string, siteName string) error
+	ListHeaderLinksFunc        func(ctx context.Context, corpName string, siteName string) ([]sigsci.HeaderLink, error)
+	GetHeaderLinkFunc          func(ctx context.Context, corpName string, siteName string, id string) (sigsci.HeaderLink, error)
+	AddHeaderLinkFunc          func(ctx context.Context, corpName string, siteName string, body sigsci.HeaderLinkBody) ([]sigsci.HeaderLink, error)
+	UpdateHeaderLinkFunc       func(ctx context.Context, corpName string, siteName string, id string, body sigsci.HeaderLinkBody) (sigsci.HeaderLink, error)
+	DeleteHeaderLinkFunc       func(ctx context.Context, corpName string, siteName string, id string) error
+	ListRedactionsFunc         func(ctx context.Context, corpName string, siteName string) ([]sigsci.Redaction, error)
+	GetRedactionFunc           func(ctx context.Context, corpName string, siteName string, id string) (sigsci.Redaction, error)
+	AddRedactionFunc           func(ctx context.Context, corpName string, siteName string, body sigsci.RedactionBody) ([]sigsci.Redaction, error)
+	UpdateRedactionFunc        func(ctx context.Context, corpName string, siteName string, id string, body sigsci.UpdateRedactionBody) (sigsci.Redaction, error)
+	DeleteRedactionFunc        func(ctx context.Context, corpName string, siteName string, id string) error
+	ListAgentsFunc             func(ctx context.Context, corpName string, siteName string) ([]sigsci.Agent, error)
+	GetAgentFunc               func(ctx context.Context, corpName string, siteName string, agentName string) (sigsci.Agent, error)
+	GetAgentLogsFunc           func(ctx context.Context, corpName string, siteName string, agentName string) ([]sigsci.AgentLog, error)
+	GetOverviewReportFunc      func(ctx context.Context, corpName string, query url.Values) ([]sigsci.OverviewSite, error)
+	ListTopAttacksFunc         func(ctx context.Context, corpName string, siteName string, query url.Values) ([]sigsci.TopAttack, error)
+	GetTimeseriesFunc          func(ctx context.Context, corpName string, siteName string, query url.Values) ([]sigsci.Timeseries, error)
+	ListEventsFunc             func(ctx context.Context, corpName string, siteName string, query url.Values) ([]sigsci.Event, error)
+	GetEventFunc               func(ctx context.Context, corpName string, siteName string, id string) (sigsci.Event, error)
+	ExpireEventFunc            func(ctx context.Context, corpName string, siteName string, id string) (sigsci.Event, error)
+	ListSuspiciousIPsFunc      func(ctx context.Context, corpName string, siteName string) ([]sigsci.SuspiciousIP, error)
+	SearchRequestsFunc         func(ctx context.Context, corpName string, siteName string, query url.Values) (string, []sigsci.Request, error)
+	GetRequestFunc             func(ctx context.Context, corpName string, siteName string, id string) (sigsci.Request, error)
+	GetRequestFeedFunc         func(ctx context.Context, corpName string, siteName string, query url.Values) (string, []sigsci.Request, error)
+	ListWhitelistIPsFunc       func(ctx context.Context, corpName string, siteName string) ([]sigsci.ListIP, error)
+	AddWhitelistIPFunc         func(ctx context.Context, corpName string, siteName string, body sigsci.ListIPBody) (sigsci.ListIP, error)
+	DeleteWhitelistIPFunc      func(ctx context.Context, corpName string, siteName string, id string) error
+	ListBlacklistIPsFunc       func(ctx context.Context, corpName string, siteName string) ([]sigsci.ListIP, error)
+	AddBlacklistIPFunc         func(ctx context.Context, corpName string, siteName string, body sigsci.ListIPBody) (sigsci.ListIP, error)
+	DeleteBlacklistIPFunc      func(ctx context.Context, corpName string, siteName string, id string) error
+	ListParamsFunc             func(ctx context.Context, corpName string, siteName string) ([]sigsci.Param, error)
+	GetParamFunc               func(ctx context.Context, corpName string, siteName string, id string) (sigsci.Param, error)
+	AddParamFunc               func(ctx context.Context, corpName string, siteName string, body sigsci.ParamBody) (sigsci.Param, error)
+	DeleteParamFunc            func(ctx context.Context, corpName string, siteName string, id string) error
+	ListPathsFunc              func(ctx context.Context, corpName string, siteName string) ([]sigsci.Path, error)
+	GetPathFunc                func(ctx context.Context, corpName string, siteName string, id string) (sigsci.Path, error)
+	AddPathFunc                func(ctx context.Context, corpName string, siteName string, body sigsci.PathBody) (sigsci.Path, error)
+	DeletePathFunc             func(ctx context.Context, corpName string, siteName string, id string) error
+	ListCustomAlertsFunc       func(ctx context.Context, corpName string, siteName string) ([]sigsci.CustomAlert, error)
+	GetCustomAlertFunc         func(ctx context.Context, corpName string, siteName string, id string) (sigsci.CustomAlert, error)
+	CreateCustomAlertFunc      func(ctx context.Context, corpName string, siteName string, body sigsci.CustomAlertBody) (sigsci.CustomAlert, error)
+	UpdateCustomAlertFunc      func(ctx context.Context, corpName string, siteName string, id string, body sigsci.CustomAlertBody) (sigsci.CustomAlert, error)
+	DeleteCustomAlertFunc      func(ctx context.Context, corpName string, siteName string, id string) error
+	ListIntegrationsFunc       func(ctx context.Context, corpName string, siteName string) ([]sigsci.Integration, error)
+	GetIntegrationFunc         func(ctx context.Context, corpName string, siteName string, id string) (sigsci.Integration, error)
+	AddIntegrationFunc         func(ctx context.Context, corpName string, siteName string, body sigsci.IntegrationBody) ([]sigsci.Integration, error)
+	UpdateIntegrationFunc      func(ctx context.Context, corpName string, siteName string, id string, body sigsci.UpdateIntegrationBody) error
+	DeleteIntegrationFunc      func(ctx context.Context, corpName string, siteName string, id string) error
+	ListCorpIntegrationsFunc   func(ctx context.Context, corpName string) ([]sigsci.Integration, error)
+	GetCorpIntegrationFunc     func(ctx context.Context, corpName string, id string) (sigsci.Integration, error)
+	AddCorpIntegrationFunc     func(ctx context.Context, corpName string, body sigsci.IntegrationBody) ([]sigsci.Integration, error)
+	UpdateCorpIntegrationFunc  func(ctx context.Context, corpName string, id string, body sigsci.UpdateIntegrationBody) error
+	DeleteCorpIntegrationFunc  func(ctx context.Context, corpName string, id string) error
+	ListRulesFunc              func(ctx context.Context, corpName string, siteName string) ([]sigsci.Rule, error)
+	GetRuleFunc                func(ctx context.Context, corpName string, siteName string, id string) (sigsci.Rule, error)
+	CreateRuleFunc             func(ctx context.Context, corpName string, siteName string, body sigsci.RuleBody) (sigsci.Rule, error)
+	UpdateRuleFunc             func(ctx context.Context, corpName string, siteName string, id string, body sigsci.RuleBody) (sigsci.Rule, error)
+	DeleteRuleFunc             func(ctx context.Context, corpName string, siteName string, id string) error
+	ListRateLimitRulesFunc     func(ctx context.Context, corpName string, siteName string) ([]sigsci.Rule, error)
+	ListCorpRulesFunc          func(ctx context.Context, corpName string) ([]sigsci.CorpRule, error)
+	GetCorpRuleFunc            func(ctx context.Context, corpName string, id string) (sigsci.CorpRule, error)
+	CreateCorpRuleFunc         func(ctx context.Context, corpName string, body sigsci.CorpRuleBody) (sigsci.CorpRule, error)
+	UpdateCorpRuleFunc         func(ctx context.Context, corpName string, id string, body sigsci.CorpRuleBody) (sigsci.CorpRule, error)
+	DeleteCorpRuleFunc         func(ctx context.Context, corpName string, id string) error
+	ListTemplatedRulesFunc     func(ctx context.Context, corpName string, siteName string) ([]sigsci.TemplatedRule, error)
+	GetTemplatedRuleFunc       func(ctx context.Context, corpName string, siteName string, name string) (sigsci.TemplatedRule, error)
+	UpdateTemplatedRuleFunc    func(ctx context.Context, corpName string, siteName string, name string, body sigsci.UpdateTemplatedRuleBody) (sigsci.TemplatedRule, error)
+	ListSiteSignalsFunc        func(ctx context.Context, corpName string, siteName string) ([]sigsci.Signal, error)
+	GetSiteSignalFunc          func(ctx context.Context, corpName string, siteName string, tagName string) (sigsci.Signal, error)
+	CreateSiteSignalFunc       func(ctx context.Context, corpName string, siteName string, body sigsci.CreateSignalBody) (sigsci.Signal, error)
+	UpdateSiteSignalFunc       func(ctx context.Context, corpName string, siteName string, tagName string, body sigsci.UpdateSignalBody) (sigsci.Signal, error)
+	DeleteSiteSignalFunc       func(ctx context.Context, corpName string, siteName string, tagName string) error
+	ListCorpSignalsFunc        func(ctx context.Context, corpName string) ([]sigsci.Signal, error)
+	GetCorpSignalFunc          func(ctx context.Context, corpName string, tagName string) (sigsci.Signal, error)
+	CreateCorpSignalFunc       func(ctx context.Context, corpName string, body sigsci.CreateSignalBody) (sigsci.Signal, error)
+	UpdateCorpSignalFunc       func(ctx context.Context, corpName string, tagName string, body sigsci.UpdateSignalBody) (sigsci.Signal, error)
+	DeleteCorpSignalFunc       func(ctx context.Context, corpName string, tagName string) error
+	ListSiteListsFunc          func(ctx context.Context, corpName string, siteName string) ([]sigsci.List, error)
+	GetSiteListFunc            func(ctx context.Context, corpName string, siteName string, id string) (sigsci.List, error)
+	CreateSiteListFunc         func(ctx context.Context, corpName string, siteName string, body sigsci.CreateListBody) (sigsci.List, error)
+	UpdateSiteListFunc         func(ctx context.Context, corpName string, siteName string, id string, body sigsci.UpdateListBody) (sigsci.List, error)
+	DeleteSiteListFunc         func(ctx context.Context, corpName string, siteName string, id string) error
+	AddSiteListEntriesFunc     func(ctx context.Context, corpName string, siteName string, id string, entries []string) (sigsci.List, error)
+	RemoveSiteListEntriesFunc  func(ctx context.Context, corpName string, siteName string, id string, entries []string) (sigsci.List, error)
+	ListCorpListsFunc          func(ctx context.Context, corpName string) ([]sigsci.List, error)
+	GetCorpListFunc            func(ctx context.Context, corpName string, id string) (sigsci.List, error)
+	CreateCorpListFunc         func(ctx context.Context, corpName string, body sigsci.CreateListBody) (sigsci.List, error)
+	UpdateCorpListFunc         func(ctx context.Context, corpName string, id string, body sigsci.UpdateListBody) (sigsci.List, error)
+	DeleteCorpListFunc         func(ctx context.Context, corpName string, id string) error
+	AddCorpListEntriesFunc     func(ctx context.Context, corpName string, id string, entries []string) (sigsci.List, error)
+	RemoveCorpListEntriesFunc  func(ctx context.Context, corpName string, id string, entries []string) (sigsci.List, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ sigsci.API = (*Client)(nil)
+
+// Logout implements sigsci.API.
+func (m *Client) Logout() error {
+	return m.LogoutContext(context.Background())
+}
+
+// LogoutContext implements sigsci.API.
+func (m *Client) LogoutContext(ctx context.Context) (err error) {
+	m.record("Logout")
+	if m.LogoutFunc != nil {
+		return m.LogoutFunc(ctx)
+	}
+
+	return
+}
+
+// ListCorps implements sigsci.API.
+func (m *Client) ListCorps() ([]sigsci.Corp, error) {
+	return m.ListCorpsContext(context.Background())
+}
+
+// ListCorpsContext implements sigsci.API.
+func (m *Client) ListCorpsContext(ctx context.Context) (r0 []sigsci.Corp, err error) {
+	m.record("ListCorps")
+	if m.ListCorpsFunc != nil {
+		return m.ListCorpsFunc(ctx)
+	}
+
+	return
+}
+
+// GetCorp implements sigsci.API.
+func (m *Client) GetCorp(corpName string) (sigsci.Corp, error) {
+	return m.GetCorpContext(context.Background(), corpName)
+}
+
+// GetCorpContext implements sigsci.API.
+func (m *Client) GetCorpContext(ctx context.Context, corpName string) (r0 sigsci.Corp, err error) {
+	m.record("GetCorp", corpName)
+	if m.GetCorpFunc != nil {
+		return m.GetCorpFunc(ctx, corpName)
+	}
+
+	return
+}
+
+// UpdateCorp implements sigsci.API.
+func (m *Client) UpdateCorp(corpName string, body sigsci.UpdateCorpBody) (sigsci.Corp, error) {
+	return m.UpdateCorpContext(context.Background(), corpName, body)
+}
+
+// UpdateCorpContext implements sigsci.API.
+func (m *Client) UpdateCorpContext(ctx context.Context, corpName string, body sigsci.UpdateCorpBody) (r0 sigsci.Corp, err error) {
+	m.record("UpdateCorp", corpName, body)
+	if m.UpdateCorpFunc != nil {
+		return m.UpdateCorpFunc(ctx, corpName, body)
+	}
+
+	return
+}
+
+// ListCorpActivity implements sigsci.API.
+func (m *Client) ListCorpActivity(corpName string, limit int, page int) ([]sigsci.ActivityEvent, error) {
+	return m.ListCorpActivityContext(context.Background(), corpName, limit, page)
+}
+
+// ListCorpActivityContext implements sigsci.API.
+func (m *Client) ListCorpActivityContext(ctx context.Context, corpName string, limit int, page int) (r0 []sigsci.ActivityEvent, err error) {
+	m.record("ListCorpActivity", corpName, limit, page)
+	if m.ListCorpActivityFunc != nil {
+		return m.ListCorpActivityFunc(ctx, corpName, limit, page)
+	}
+
+	return
+}
+
+// ListCorpUsers implements sigsci.API.
+func (m *Client) ListCorpUsers(corpName string) ([]sigsci.CorpUser, error) {
+	return m.ListCorpUsersContext(context.Background(), corpName)
+}
+
+// ListCorpUsersContext implements sigsci.API.
+func (m *Client) ListCorpUsersContext(ctx context.Context, corpName string) (r0 []sigsci.CorpUser, err error) {
+	m.record("ListCorpUsers", corpName)
+	if m.ListCorpUsersFunc != nil {
+		return m.ListCorpUsersFunc(ctx, corpName)
+	}
+
+	return
+}
+
+// GetCorpUser implements sigsci.API.
+func (m *Client) GetCorpUser(corpName string, email string) (sigsci.CorpUser, error) {
+	return m.GetCorpUserContext(context.Background(), corpName, email)
+}
+
+// GetCorpUserContext implements sigsci.API.
+func (m *Client) GetCorpUserContext(ctx context.Context, corpName string, email string) (r0 sigsci.CorpUser, err error) {
+	m.record("GetCorpUser", corpName, email)
+	if m.GetCorpUserFunc != nil {
+		return m.GetCorpUserFunc(ctx, corpName, email)
+	}
+
+	return
+}
+
+// InviteUser implements sigsci.API.
+func (m *Client) InviteUser(corpName string, email string, invite sigsci.CorpUserInvite) (sigsci.CorpUser, error) {
+	return m.InviteUserContext(context.Background(), corpName, email, invite)
+}
+
+// InviteUserContext implements sigsci.API.
+func (m *Client) InviteUserContext(ctx context.Context, corpName string, email string, invite sigsci.CorpUserInvite) (r0 sigsci.CorpUser, err error) {
+	m.record("InviteUser", corpName, email, invite)
+	if m.InviteUserFunc != nil {
+		return m.InviteUserFunc(ctx, corpName, email, invite)
+	}
+
+	return
+}
+
+// UpdateCorpUser implements sigsci.API.
+func (m *Client) UpdateCorpUser(corpName string, email string, body sigsci.CorpUserInvite) (sigsci.CorpUser, error) {
+	return m.UpdateCorpUserContext(context.Background(), corpName, email, body)
+}
+
+// UpdateCorpUserContext implements sigsci.API.
+func (m *Client) UpdateCorpUserContext(ctx context.Context, corpName string, email string, body sigsci.CorpUserInvite) (r0 sigsci.CorpUser, err error) {
+	m.record("UpdateCorpUser", corpName, email, body)
+	if m.UpdateCorpUserFunc != nil {
+		return m.UpdateCorpUserFunc(ctx, corpName, email, body)
+	}
+
+	return
+}
+
+// DeleteCorpUser implements sigsci.API.
+func (m *Client) DeleteCorpUser(corpName string, email string) error {
+	return m.DeleteCorpUserContext(context.Background(), corpName, email)
+}
+
+// DeleteCorpUserContext implements sigsci.API.
+func (m *Client) DeleteCorpUserContext(ctx context.Context, corpName string, email string) (err error) {
+	m.record("DeleteCorpUser", corpName, email)
+	if m.DeleteCorpUserFunc != nil {
+		return m.DeleteCorpUserFunc(ctx, corpName, email)
+	}
+
+	return
+}
+
+// ListSiteMembers implements sigsci.API.
+func (m *Client) ListSiteMembers(corpName string, siteName string) ([]sigsci.SiteMember, error) {
+	return m.ListSiteMembersContext(context.Background(), corpName, siteName)
+}
+
+// ListSiteMembersContext implements sigsci.API.
+func (m *Client) ListSiteMembersContext(ctx context.Context, corpName string, siteName string) (r0 []sigsci.SiteMember, err error) {
+	m.record("ListSiteMembers", corpName, siteName)
+	if m.ListSiteMembersFunc != nil {
+		return m.ListSiteMembersFunc(ctx, corpName, siteName)
+	}
+
+	return
+}
+
+// GetSiteMember implements sigsci.API.
+func (m *Client) GetSiteMember(corpName string, siteName string, email string) (sigsci.SiteMember, error) {
+	return m.GetSiteMemberContext(context.Background(), corpName, siteName, email)
+}
+
+// GetSiteMemberContext implements sigsci.API.
+func (m *Client) GetSiteMemberContext(ctx context.Context, corpName string, siteName string, email string) (r0 sigsci.SiteMember, err error) {
+	m.record("GetSiteMember", corpName, siteName, email)
+	if m.GetSiteMemberFunc != nil {
+		return m.GetSiteMemberFunc(ctx, corpName, siteName, email)
+	}
+
+	return
+}
+
+// AddSiteMember implements sigsci.API.
+func (m *Client) AddSiteMember(corpName string, siteName string, email string) (sigsci.SiteMemberResponse, error) {
+	return m.AddSiteMemberContext(context.Background(), corpName, siteName, email)
+}
+
+// AddSiteMemberContext implements sigsci.API.
+func (m *Client) AddSiteMemberContext(ctx context.Context, corpName string, siteName string, email string) (r0 sigsci.SiteMemberResponse, err error) {
+	m.record("AddSiteMember", corpName, siteName, email)
+	if m.AddSiteMemberFunc != nil {
+		return m.AddSiteMemberFunc(ctx, corpName, siteName, email)
+	}
+
+	return
+}
+
+// AddSiteMembers implements sigsci.API.
+func (m *Client) AddSiteMembers(corpName string, siteName string, body sigsci.SiteMembersBody) ([]sigsci.SiteMember, error) {
+	return m.AddSiteMembersContext(context.Background(), corpName, siteName, body)
+}
+
+// AddSiteMembersContext implements sigsci.API.
+func (m *Client) AddSiteMembersContext(ctx context.Context, corpName string, siteName string, body sigsci.SiteMembersBody) (r0 []sigsci.SiteMember, err error) {
+	m.record("AddSiteMembers", corpName, siteName, body)
+	if m.AddSiteMembersFunc != nil {
+		return m.AddSiteMembersFunc(ctx, corpName, siteName, body)
+	}
+
+	return
+}
+
+// InviteSiteMember implements sigsci.API.
+func (m *Client) InviteSiteMember(corpName string, siteName string, email string, body sigsci.SiteMemberBody) (sigsci.SiteMemberResponse, error) {
+	return m.InviteSiteMemberContext(context.Background(), corpName, siteName, email, body)
+}
+
+// InviteSiteMemberContext implements sigsci.API.
+func (m *Client) InviteSiteMemberContext(ctx context.Context, corpName string, siteName string, email string, body sigsci.SiteMemberBody) (r0 sigsci.SiteMemberResponse, err error) {
+	m.record("InviteSiteMember", corpName, siteName, email, body)
+	if m.InviteSiteMemberFunc != nil {
+		return m.InviteSiteMemberFunc(ctx, corpName, siteName, email, body)
+	}
+
+	return
+}
+
+// SetSiteMemberRole implements sigsci.API.
+func (m *Client) SetSiteMemberRole(corpName string, siteName string, email string, role sigsci.Role) (sigsci.SiteMemberResponse, error) {
+	return m.SetSiteMemberRoleContext(context.Background(), corpName, siteName, email, role)
+}
+
+// SetSiteMemberRoleContext implements sigsci.API.
+func (m *Client) SetSiteMemberRoleContext(ctx context.Context, corpName string, siteName string, email string, role sigsci.Role) (r0 sigsci.SiteMemberResponse, err error) {
+	m.record("SetSiteMemberRole", corpName, siteName, email, role)
+	if m.SetSiteMemberRoleFunc != nil {
+		return m.SetSiteMemberRoleFunc(ctx, corpName, siteName, email, role)
+	}
+
+	return
+}
+
+// DeleteSiteMember implements sigsci.API.
+func (m *Client) DeleteSiteMember(corpName string, siteName string, email string) error {
+	return m.DeleteSiteMemberContext(context.Background(), corpName, siteName, email)
+}
+
+// DeleteSiteMemberContext implements sigsci.API.
+func (m *Client) DeleteSiteMemberContext(ctx context.Context, corpName string, siteName string, email string) (err error) {
+	m.record("DeleteSiteMember", corpName, siteName, email)
+	if m.DeleteSiteMemberFunc != nil {
+		return m.DeleteSiteMemberFunc(ctx, corpName, siteName, email)
+	}
+
+	return
+}
+
+// ListAPITokens implements sigsci.API.
+func (m *Client) ListAPITokens() ([]sigsci.APIToken, error) {
+	return m.ListAPITokensContext(context.Background())
+}
+
+// ListAPITokensContext implements sigsci.API.
+func (m *Client) ListAPITokensContext(ctx context.Context) (r0 []sigsci.APIToken, err error) {
+	m.record("ListAPITokens")
+	if m.ListAPITokensFunc != nil {
+		return m.ListAPITokensFunc(ctx)
+	}
+
+	return
+}
+
+// CreateAPIToken implements sigsci.API.
+func (m *Client) CreateAPIToken(body sigsci.CreateAPITokenBody) (sigsci.APIToken, error) {
+	return m.CreateAPITokenContext(context.Background(), body)
+}
+
+// CreateAPITokenContext implements sigsci.API.
+func (m *Client) CreateAPITokenContext(ctx context.Context, body sigsci.CreateAPITokenBody) (r0 sigsci.APIToken, err error) {
+	m.record("CreateAPIToken", body)
+	if m.CreateAPITokenFunc != nil {
+		return m.CreateAPITokenFunc(ctx, body)
+	}
+
+	return
+}
+
+// GetAPIToken implements sigsci.API.
+func (m *Client) GetAPIToken(id string) (sigsci.APIToken, error) {
+	return m.GetAPITokenContext(context.Background(), id)
+}
+
+// GetAPITokenContext implements sigsci.API.
+func (m *Client) GetAPITokenContext(ctx context.Context, id string) (r0 sigsci.APIToken, err error) {
+	m.record("GetAPIToken", id)
+	if m.GetAPITokenFunc != nil {
+		return m.GetAPITokenFunc(ctx, id)
+	}
+
+	return
+}
+
+// DeleteAPIToken implements sigsci.API.
+func (m *Client) DeleteAPIToken(id string) error {
+	return m.DeleteAPITokenContext(context.Background(), id)
+}
+
+// DeleteAPITokenContext implements sigsci.API.
+func (m *Client) DeleteAPITokenContext(ctx context.Context, id string) (err error) {
+	m.record("DeleteAPIToken", id)
+	if m.DeleteAPITokenFunc != nil {
+		return m.DeleteAPITokenFunc(ctx, id)
+	}
+
+	return
+}
+
+// ListCorpUserAPITokens implements sigsci.API.
+func (m *Client) ListCorpUserAPITokens(corpName string, email string) ([]sigsci.APIToken, error) {
+	return m.ListCorpUserAPITokensContext(context.Background(), corpName, email)
+}
+
+// ListCorpUserAPITokensContext implements sigsci.API.
+func (m *Client) ListCorpUserAPITokensContext(ctx context.Context, corpName string, email string) (r0 []sigsci.APIToken, err error) {
+	m.record("ListCorpUserAPITokens", corpName, email)
+	if m.ListCorpUserAPITokensFunc != nil {
+		return m.ListCorpUserAPITokensFunc(ctx, corpName, email)
+	}
+
+	return
+}
+
+// DeleteCorpUserAPIToken implements sigsci.API.
+func (m *Client) DeleteCorpUserAPIToken(corpName string, email string, id string) error {
+	return m.DeleteCorpUserAPITokenContext(context.Background(), corpName, email, id)
+}
+
+// DeleteCorpUserAPITokenContext implements sigsci.API.
+func (m *Client) DeleteCorpUserAPITokenContext(ctx context.Context, corpName string, email string, id string) (err error) {
+	m.record("DeleteCorpUserAPIToken", corpName, email, id)
+	if m.DeleteCorpUserAPITokenFunc != nil {
+		return m.DeleteCorpUserAPITokenFunc(ctx, corpName, email, id)
+	}
+
+	return
+}
+
+// RotateToken implements sigsci.API.
+func (m *Client) RotateToken(email string, name string, oldID string) (sigsci.APIToken, error) {
+	return m.RotateTokenContext(context.Background(), email, name, oldID)
+}
+
+// RotateTokenContext implements sigsci.API.
+func (m *Client) RotateTokenContext(ctx context.Context, email string, name string, oldID string) (r0 sigsci.APIToken, err error) {
+	m.record("RotateToken", email, name, oldID)
+	if m.RotateTokenFunc != nil {
+		return m.RotateTokenFunc(ctx, email, name, oldID)
+	}
+
+	return
+}
+
+// ListSites implements sigsci.API.
+func (m *Client) ListSites(corpName string) ([]sigsci.Site, error) {
+	return m.ListSitesContext(context.Background(), corpName)
+}
+
+// ListSitesContext implements sigsci.API.
+func (m *Client) ListSitesContext(ctx context.Context, corpName string) (r0 []sigsci.Site, err error) {
+	m.record("ListSites", corpName)
+	if m.ListSitesFunc != nil {
+		return m.ListSitesFunc(ctx, corpName)
+	}
+
+	return
+}
+
+// GetSite implements sigsci.API.
+func (m *Client) GetSite(corpName string, siteName string) (sigsci.Site, error) {
+	return m.GetSiteContext(context.Background(), corpName, siteName)
+}
+
+// GetSiteContext implements sigsci.API.
+func (m *Client) GetSiteContext(ctx context.Context, corpName string, siteName string) (r0 sigsci.Site, err error) {
+	m.record("GetSite", corpName, siteName)
+	if m.GetSiteFunc != nil {
+		return m.GetSiteFunc(ctx, corpName, siteName)
+	}
+
+	return
+}
+
+// CreateSite implements sigsci.API.
+func (m *Client) CreateSite(corpName string, body sigsci.CreateSiteBody) (sigsci.Site, error) {
+	return m.CreateSiteContext(context.Background(), corpName, body)
+}
+
+// CreateSiteContext implements sigsci.API.
+func (m *Client) CreateSiteContext(ctx context.Context, corpName string, body sigsci.CreateSiteBody) (r0 sigsci.Site, err error) {
+	m.record("CreateSite", corpName, body)
+	if m.CreateSiteFunc != nil {
+		return m.CreateSiteFunc(ctx, corpName, body)
+	}
+
+	return
+}
+
+// UpdateSite implements sigsci.API.
+func (m *Client) UpdateSite(corpName string, siteName string, body sigsci.UpdateSiteBody) (sigsci.Site, error) {
+	return m.UpdateSiteContext(context.Background(), corpName, siteName, body)
+}
+
+// UpdateSiteContext implements sigsci.API.
+func (m *Client) UpdateSiteContext(ctx context.Context, corpName string, siteName string, body sigsci.UpdateSiteBody) (r0 sigsci.Site, err error) {
+	m.record("UpdateSite", corpName, siteName, body)
+	if m.UpdateSiteFunc != nil {
+		return m.UpdateSiteFunc(ctx, corpName, siteName, body)
+	}
+
+	return
+}
+
+// DeleteSite implements sigsci.API.
+func (m *Client) DeleteSite(corpName string, siteName string) error {
+	return m.DeleteSiteContext(context.Background(), corpName, siteName)
+}
+
+// DeleteSiteContext implements sigsci.API.
+func (m *Client) DeleteSiteContext(ctx context.Context, corpName string, siteName string) (err error) {
+	m.record("DeleteSite", corpName, siteName)
+	if m.DeleteSiteFunc != nil {
+		return m.DeleteSiteFunc(ctx, corpName, siteName)
+	}
+
+	return
+}
+
+// ListSiteActivity implements sigsci.API.
+func (m *Client) ListSiteActivity(corpName string, siteName string, limit int, page int) ([]sigsci.ActivityEvent, error) {
+	return m.ListSiteActivityContext(context.Background(), corpName, siteName, limit, page)
+}
+
+// ListSiteActivityContext implements sigsci.API.
+func (m *Client) ListSiteActivityContext(ctx context.Context, corpName string, siteName string, limit int, page int) (r0 []sigsci.ActivityEvent, err error) {
+	m.record("ListSiteActivity", corpName, siteName, limit, page)
+	if m.ListSiteActivityFunc != nil {
+		return m.ListSiteActivityFunc(ctx, corpName, siteName, limit, page)
+	}
+
+	return
+}
+
+// GetSiteMonitor implements sigsci.API.
+func (m *Client) GetSiteMonitor(corpName string, siteName string, email string) (sigsci.SiteMonitor, error) {
+	return m.GetSiteMonitorContext(context.Background(), corpName, siteName, email)
+}
+
+// GetSiteMonitorContext implements sigsci.API.
+func (m *Client) GetSiteMonitorContext(ctx context.Context, corpName string, siteName string, email string) (r0 sigsci.SiteMonitor, err error) {
+	m.record("GetSiteMonitor", corpName, siteName, email)
+	if m.GetSiteMonitorFunc != nil {
+		return m.GetSiteMonitorFunc(ctx, corpName, siteName, email)
+	}
+
+	return
+}
+
+// GenerateSiteMonitor implements sigsci.API.
+func (m *Client) GenerateSiteMonitor(corpName string, siteName string) (sigsci.SiteMonitor, error) {
+	return m.GenerateSiteMonitorContext(context.Background(), corpName, siteName)
+}
+
+// GenerateSiteMonitorContext implements sigsci.API.
+func (m *Client) GenerateSiteMonitorContext(ctx context.Context, corpName string, siteName string) (r0 sigsci.SiteMonitor, err error) {
+	m.record("GenerateSiteMonitor", corpName, siteName)
+	if m.GenerateSiteMonitorFunc != nil {
+		return m.GenerateSiteMonitorFunc(ctx, corpName, siteName)
+	}
+
+	return
+}
+
+// EnableSiteMonitor implements sigsci.API.
+func (m *Client) EnableSiteMonitor(corpName string, siteName string) error {
+	return m.EnableSiteMonitorContext(context.Background(), corpName, siteName)
+}
+
+// EnableSiteMonitorContext implements sigsci.API.
+func (m *Client) EnableSiteMonitorContext(ctx context.Context, corpName string, siteName string) (err error) {
+	m.record("EnableSiteMonitor", corpName, siteName)
+	if m.EnableSiteMonitorFunc != nil {
+		return m.EnableSiteMonitorFunc(ctx, corpName, siteName)
+	}
+
+	return
+}
+
+// DisableSiteMonitor implements sigsci.API.
+func (m *Client) DisableSiteMonitor(corpName string, siteName string) error {
+	return m.DisableSiteMonitorContext(context.Background(), corpName, siteName)
+}
+
+// DisableSiteMonitorContext implements sigsci.API.
+func (m *Client) DisableSiteMonitorContext(ctx context.Context, corpName string, siteName string) (err error) {
+	m.record("DisableSiteMonitor", corpName, siteName)
+	if m.DisableSiteMonitorFunc != nil {
+		return m.DisableSiteMonitorFunc(ctx, corpName, siteName)
+	}
+
+	return
+}
+
+// ListHeaderLinks implements sigsci.API.
+func (m *Client) ListHeaderLinks(corpName string, siteName string) ([]sigsci.HeaderLink, error) {
+	return m.ListHeaderLinksContext(context.Background(), corpName, siteName)
+}
+
+// ListHeaderLinksContext implements sigsci.API.
+func (m *Client) ListHeaderLinksContext(ctx context.Context, corpName string, siteName string) (r0 []sigsci.HeaderLink, err error) {
+	m.record("ListHeaderLinks", corpName, siteName)
+	if m.ListHeaderLinksFunc != nil {
+		return m.ListHeaderLinksFunc(ctx, corpName, siteName)
+	}
+
+	return
+}
+
+// GetHeaderLink implements sigsci.API.
+func (m *Client) GetHeaderLink(corpName string, siteName string, id string) (sigsci.HeaderLink, error) {
+	return m.GetHeaderLinkContext(context.Background(), corpName, siteName, id)
+}
+
+// GetHeaderLinkContext implements sigsci.API.
+func (m *Client) GetHeaderLinkContext(ctx context.Context, corpName string, siteName string, id string) (r0 sigsci.HeaderLink, err error) {
+	m.record("GetHeaderLink", corpName, siteName, id)
+	if m.GetHeaderLinkFunc != nil {
+		return m.GetHeaderLinkFunc(ctx, corpName, siteName, id)
+	}
+
+	return
+}
+
+// AddHeaderLink implements sigsci.API.
+func (m *Client) AddHeaderLink(corpName string, siteName string, body sigsci.HeaderLinkBody) ([]sigsci.HeaderLink, error) {
+	return m.AddHeaderLinkContext(context.Background(), corpName, siteName, body)
+}
+
+// AddHeaderLinkContext implements sigsci.API.
+func (m *Client) AddHeaderLinkContext(ctx context.Context, corpName string, siteName string, body sigsci.HeaderLinkBody) (r0 []sigsci.HeaderLink, err error) {
+	m.record("AddHeaderLink", corpName, siteName, body)
+	if m.AddHeaderLinkFunc != nil {
+		return m.AddHeaderLinkFunc(ctx, corpName, siteName, body)
+	}
+
+	return
+}
+
+// UpdateHeaderLink implements sigsci.API.
+func (m *Client) UpdateHeaderLink(corpName string, siteName string, id string, body sigsci.HeaderLinkBody) (sigsci.HeaderLink, error) {
+	return m.UpdateHeaderLinkContext(context.Background(), corpName, siteName, id, body)
+}
+
+// UpdateHeaderLinkContext implements sigsci.API.
+func (m *Client) UpdateHeaderLinkContext(ctx context.Context, corpName string, siteName string, id string, body sigsci.HeaderLinkBody) (r0 sigsci.HeaderLink, err error) {
+	m.record("UpdateHeaderLink", corpName, siteName, id, body)
+	if m.UpdateHeaderLinkFunc != nil {
+		return m.UpdateHeaderLinkFunc(ctx, corpName, siteName, id, body)
+	}
+
+	return
+}
+
+// DeleteHeaderLink implements sigsci.API.
+func (m *Client) DeleteHeaderLink(corpName string, siteName string, id string) error {
+	return m.DeleteHeaderLinkContext(context.Background(), corpName, siteName, id)
+}
+
+// DeleteHeaderLinkContext implements sigsci.API.
+func (m *Client) DeleteHeaderLinkContext(ctx context.Context, corpName string, siteName string, id string) (err error) {
+	m.record("DeleteHeaderLink", corpName, siteName, id)
+	if m.DeleteHeaderLinkFunc != nil {
+		return m.DeleteHeaderLinkFunc(ctx, corpName, siteName, id)
+	}
+
+	return
+}
+
+// ListRedactions implements sigsci.API.
+func (m *Client) ListRedactions(corpName string, siteName string) ([]sigsci.Redaction, error) {
+	return m.ListRedactionsContext(context.Background(), corpName, siteName)
+}
+
+// ListRedactionsContext implements sigsci.API.
+func (m *Client) ListRedactionsContext(ctx context.Context, corpName string, siteName string) (r0 []sigsci.Redaction, err error) {
+	m.record("ListRedactions", corpName, siteName)
+	if m.ListRedactionsFunc != nil {
+		return m.ListRedactionsFunc(ctx, corpName, siteName)
+	}
+
+	return
+}
+
+// GetRedaction implements sigsci.API.
+func (m *Client) GetRedaction(corpName string, siteName string, id string) (sigsci.Redaction, error) {
+	return m.GetRedactionContext(context.Background(), corpName, siteName, id)
+}
+
+// GetRedactionContext implements sigsci.API.
+func (m *Client) GetRedactionContext(ctx context.Context, corpName string, siteName string, id string) (r0 sigsci.Redaction, err error) {
+	m.record("GetRedaction", corpName, siteName, id)
+	if m.GetRedactionFunc != nil {
+		return m.GetRedactionFunc(ctx, corpName, siteName, id)
+	}
+
+	return
+}
+
+// AddRedaction implements sigsci.API.
+func (m *Client) AddRedaction(corpName string, siteName string, body sigsci.RedactionBody) ([]sigsci.Redaction, error) {
+	return m.AddRedactionContext(context.Background(), corpName, siteName, body)
+}
+
+// AddRedactionContext implements sigsci.API.
+func (m *Client) AddRedactionContext(ctx context.Context, corpName string, siteName string, body sigsci.RedactionBody) (r0 []sigsci.Redaction, err error) {
+	m.record("AddRedaction", corpName, siteName, body)
+	if m.AddRedactionFunc != nil {
+		return m.AddRedactionFunc(ctx, corpName, siteName, body)
+	}
+
+	return
+}
+
+// UpdateRedaction implements sigsci.API.
+func (m *Client) UpdateRedaction(corpName string, siteName string, id string, body sigsci.UpdateRedactionBody) (sigsci.Redaction, error) {
+	return m.UpdateRedactionContext(context.Background(), corpName, siteName, id, body)
+}
+
+// UpdateRedactionContext implements sigsci.API.
+func (m *Client) UpdateRedactionContext(ctx context.Context, corpName string, siteName string, id string, body sigsci.UpdateRedactionBody) (r0 sigsci.Redaction, err error) {
+	m.record("UpdateRedaction", corpName, siteName, id, body)
+	if m.UpdateRedactionFunc != nil {
+		return m.UpdateRedactionFunc(ctx, corpName, siteName, id, body)
+	}
+
+	return
+}
+
+// DeleteRedaction implements sigsci.API.
+func (m *Client) DeleteRedaction(corpName string, siteName string, id string) error {
+	return m.DeleteRedactionContext(context.Background(), corpName, siteName, id)
+}
+
+// DeleteRedactionContext implements sigsci.API.
+func (m *Client) DeleteRedactionContext(ctx context.Context, corpName string, siteName string, id string) (err error) {
+	m.record("DeleteRedaction", corpName, siteName, id)
+	if m.DeleteRedactionFunc != nil {
+		return m.DeleteRedactionFunc(ctx, corpName, siteName, id)
+	}
+
+	return
+}
+
+// ListAgents implements sigsci.API.
+func (m *Client) ListAgents(corpName string, siteName string) ([]sigsci.Agent, error) {
+	return m.ListAgentsContext(context.Background(), corpName, siteName)
+}
+
+// ListAgentsContext implements sigsci.API.
+func (m *Client) ListAgentsContext(ctx context.Context, corpName string, siteName string) (r0 []sigsci.Agent, err error) {
+	m.record("ListAgents", corpName, siteName)
+	if m.ListAgentsFunc != nil {
+		return m.ListAgentsFunc(ctx, corpName, siteName)
+	}
+
+	return
+}
+
+// GetAgent implements sigsci.API.
+func (m *Client) GetAgent(corpName string, siteName string, agentName string) (sigsci.Agent, error) {
+	return m.GetAgentContext(context.Background(), corpName, siteName, agentName)
+}
+
+// GetAgentContext implements sigsci.API.
+func (m *Client) GetAgentContext(ctx context.Context, corpName string, siteName string, agentName string) (r0 sigsci.Agent, err error) {
+	m.record("GetAgent", corpName, siteName, agentName)
+	if m.GetAgentFunc != nil {
+		return m.GetAgentFunc(ctx, corpName, siteName, agentName)
+	}
+
+	return
+}
+
+// GetAgentLogs implements sigsci.API.
+func (m *Client) GetAgentLogs(corpName string, siteName string, agentName string) ([]sigsci.AgentLog, error) {
+	return m.GetAgentLogsContext(context.Background(), corpName, siteName, agentName)
+}
+
+// GetAgentLogsContext implements sigsci.API.
+func (m *Client) GetAgentLogsContext(ctx context.Context, corpName string, siteName string, agentName string) (r0 []sigsci.AgentLog, err error) {
+	m.record("GetAgentLogs", corpName, siteName, agentName)
+	if m.GetAgentLogsFunc != nil {
+		return m.GetAgentLogsFunc(ctx, corpName, siteName, agentName)
+	}
+
+	return
+}
+
+// GetOverviewReport implements sigsci.API.
+func (m *Client) GetOverviewReport(corpName string, query url.Values) ([]sigsci.OverviewSite, error) {
+	return m.GetOverviewReportContext(context.Background(), corpName, query)
+}
+
+// GetOverviewReportContext implements sigsci.API.
+func (m *Client) GetOverviewReportContext(ctx context.Context, corpName string, query url.Values) (r0 []sigsci.OverviewSite, err error) {
+	m.record("GetOverviewReport", corpName, query)
+	if m.GetOverviewReportFunc != nil {
+		return m.GetOverviewReportFunc(ctx, corpName, query)
+	}
+
+	return
+}
+
+// ListTopAttacks implements sigsci.API.
+func (m *Client) ListTopAttacks(corpName string, siteName string, query url.Values) ([]sigsci.TopAttack, error) {
+	return m.ListTopAttacksContext(context.Background(), corpName, siteName, query)
+}
+
+// ListTopAttacksContext implements sigsci.API.
+func (m *Client) ListTopAttacksContext(ctx context.Context, corpName string, siteName string, query url.Values) (r0 []sigsci.TopAttack, err error) {
+	m.record("ListTopAttacks", corpName, siteName, query)
+	if m.ListTopAttacksFunc != nil {
+		return m.ListTopAttacksFunc(ctx, corpName, siteName, query)
+	}
+
+	return
+}
+
+// GetTimeseries implements sigsci.API.
+func (m *Client) GetTimeseries(corpName string, siteName string, query url.Values) ([]sigsci.Timeseries, error) {
+	return m.GetTimeseriesContext(context.Background(), corpName, siteName, query)
+}
+
+// GetTimeseriesContext implements sigsci.API.
+func (m *Client) GetTimeseriesContext(ctx context.Context, corpName string, siteName string, query url.Values) (r0 []sigsci.Timeseries, err error) {
+	m.record("GetTimeseries", corpName, siteName, query)
+	if m.GetTimeseriesFunc != nil {
+		return m.GetTimeseriesFunc(ctx, corpName, siteName, query)
+	}
+
+	return
+}
+
+// ListEvents implements sigsci.API.
+func (m *Client) ListEvents(corpName string, siteName string, query url.Values) ([]sigsci.Event, error) {
+	return m.ListEventsContext(context.Background(), corpName, siteName, query)
+}
+
+// ListEventsContext implements sigsci.API.
+func (m *Client) ListEventsContext(ctx context.Context, corpName string, siteName string, query url.Values) (r0 []sigsci.Event, err error) {
+	m.record("ListEvents", corpName, siteName, query)
+	if m.ListEventsFunc != nil {
+		return m.ListEventsFunc(ctx, corpName, siteName, query)
+	}
+
+	return
+}
+
+// GetEvent implements sigsci.API.
+func (m *Client) GetEvent(corpName string, siteName string, id string) (sigsci.Event, error) {
+	return m.GetEventContext(context.Background(), corpName, siteName, id)
+}
+
+// GetEventContext implements sigsci.API.
+func (m *Client) GetEventContext(ctx context.Context, corpName string, siteName string, id string) (r0 sigsci.Event, err error) {
+	m.record("GetEvent", corpName, siteName, id)
+	if m.GetEventFunc != nil {
+		return m.GetEventFunc(ctx, corpName, siteName, id)
+	}
+
+	return
+}
+
+// ExpireEvent implements sigsci.API.
+func (m *Client) ExpireEvent(corpName string, siteName string, id string) (sigsci.Event, error) {
+	return m.ExpireEventContext(context.Background(), corpName, siteName, id)
+}
+
+// ExpireEventContext implements sigsci.API.
+func (m *Client) ExpireEventContext(ctx context.Context, corpName string, siteName string, id string) (r0 sigsci.Event, err error) {
+	m.record("ExpireEvent", corpName, siteName, id)
+	if m.ExpireEventFunc != nil {
+		return m.ExpireEventFunc(ctx, corpName, siteName, id)
+	}
+
+	return
+}
+
+// ListSuspiciousIPs implements sigsci.API.
+func (m *Client) ListSuspiciousIPs(corpName string, siteName string) ([]sigsci.SuspiciousIP, error) {
+	return m.ListSuspiciousIPsContext(context.Background(), corpName, siteName)
+}
+
+// ListSuspiciousIPsContext implements sigsci.API.
+func (m *Client) ListSuspiciousIPsContext(ctx context.Context, corpName string, siteName string) (r0 []sigsci.SuspiciousIP, err error) {
+	m.record("ListSuspiciousIPs", corpName, siteName)
+	if m.ListSuspiciousIPsFunc != nil {
+		return m.ListSuspiciousIPsFunc(ctx, corpName, siteName)
+	}
+
+	return
+}
+
+// SearchRequests implements sigsci.API.
+func (m *Client) SearchRequests(corpName string, siteName string, query url.Values) (string, []sigsci.Request, error) {
+	return m.SearchRequestsContext(context.Background(), corpName, siteName, query)
+}
+
+// SearchRequestsContext implements sigsci.API.
+func (m *Client) SearchRequestsContext(ctx context.Context, corpName string, siteName string, query url.Values) (r0 string, r1 []sigsci.Request, err error) {
+	m.record("SearchRequests", corpName, siteName, query)
+	if m.SearchRequestsFunc != nil {
+		return m.SearchRequestsFunc(ctx, corpName, siteName, query)
+	}
+
+	return
+}
+
+// GetRequest implements sigsci.API.
+func (m *Client) GetRequest(corpName string, siteName string, id string) (sigsci.Request, error) {
+	return m.GetRequestContext(context.Background(), corpName, siteName, id)
+}
+
+// GetRequestContext implements sigsci.API.
+func (m *Client) GetRequestContext(ctx context.Context, corpName string, siteName string, id string) (r0 sigsci.Request, err error) {
+	m.record("GetRequest", corpName, siteName, id)
+	if m.GetRequestFunc != nil {
+		return m.GetRequestFunc(ctx, corpName, siteName, id)
+	}
+
+	return
+}
+
+// GetRequestFeed implements sigsci.API.
+func (m *Client) GetRequestFeed(corpName string, siteName string, query url.Values) (string, []sigsci.Request, error) {
+	return m.GetRequestFeedContext(context.Background(), corpName, siteName, query)
+}
+
+// GetRequestFeedContext implements sigsci.API.
+func (m *Client) GetRequestFeedContext(ctx context.Context, corpName string, siteName string, query url.Values) (r0 string, r1 []sigsci.Request, err error) {
+	m.record("GetRequestFeed", corpName, siteName, query)
+	if m.GetRequestFeedFunc != nil {
+		return m.GetRequestFeedFunc(ctx, corpName, siteName, query)
+	}
+
+	return
+}
+
+// ListWhitelistIPs implements sigsci.API.
+func (m *Client) ListWhitelistIPs(corpName string, siteName string) ([]sigsci.ListIP, error) {
+	return m.ListWhitelistIPsContext(context.Background(), corpName, siteName)
+}
+
+// ListWhitelistIPsContext implements sigsci.API.
+func (m *Client) ListWhitelistIPsContext(ctx context.Context, corpName string, siteName string) (r0 []sigsci.ListIP, err error) {
+	m.record("ListWhitelistIPs", corpName, siteName)
+	if m.ListWhitelistIPsFunc != nil {
+		return m.ListWhitelistIPsFunc(ctx, corpName, siteName)
+	}
+
+	return
+}
+
+// AddWhitelistIP implements sigsci.API.
+func (m *Client) AddWhitelistIP(corpName string, siteName string, body sigsci.ListIPBody) (sigsci.ListIP, error) {
+	return m.AddWhitelistIPContext(context.Background(), corpName, siteName, body)
+}
+
+// AddWhitelistIPContext implements sigsci.API.
+func (m *Client) AddWhitelistIPContext(ctx context.Context, corpName string, siteName string, body sigsci.ListIPBody) (r0 sigsci.ListIP, err error) {
+	m.record("AddWhitelistIP", corpName, siteName, body)
+	if m.AddWhitelistIPFunc != nil {
+		return m.AddWhitelistIPFunc(ctx, corpName, siteName, body)
+	}
+
+	return
+}
+
+// DeleteWhitelistIP implements sigsci.API.
+func (m *Client) DeleteWhitelistIP(corpName string, siteName string, id string) error {
+	return m.DeleteWhitelistIPContext(context.Background(), corpName, siteName, id)
+}
+
+// DeleteWhitelistIPContext implements sigsci.API.
+func (m *Client) DeleteWhitelistIPContext(ctx context.Context, corpName string, siteName string, id string) (err error) {
+	m.record("DeleteWhitelistIP", corpName, siteName, id)
+	if m.DeleteWhitelistIPFunc != nil {
+		return m.DeleteWhitelistIPFunc(ctx, corpName, siteName, id)
+	}
+
+	return
+}
+
+// ListBlacklistIPs implements sigsci.API.
+func (m *Client) ListBlacklistIPs(corpName string, siteName string) ([]sigsci.ListIP, error) {
+	return m.ListBlacklistIPsContext(context.Background(), corpName, siteName)
+}
+
+// ListBlacklistIPsContext implements sigsci.API.
+func (m *Client) ListBlacklistIPsContext(ctx context.Context, corpName string, siteName string) (r0 []sigsci.ListIP, err error) {
+	m.record("ListBlacklistIPs", corpName, siteName)
+	if m.ListBlacklistIPsFunc != nil {
+		return m.ListBlacklistIPsFunc(ctx, corpName, siteName)
+	}
+
+	return
+}
+
+// AddBlacklistIP implements sigsci.API.
+func (m *Client) AddBlacklistIP(corpName string, siteName string, body sigsci.ListIPBody) (sigsci.ListIP, error) {
+	return m.AddBlacklistIPContext(context.Background(), corpName, siteName, body)
+}
+
+// AddBlacklistIPContext implements sigsci.API.
+func (m *Client) AddBlacklistIPContext(ctx context.Context, corpName string, siteName string, body sigsci.ListIPBody) (r0 sigsci.ListIP, err error) {
+	m.record("AddBlacklistIP", corpName, siteName, body)
+	if m.AddBlacklistIPFunc != nil {
+		return m.AddBlacklistIPFunc(ctx, corpName, siteName, body)
+	}
+
+	return
+}
+
+// DeleteBlacklistIP implements sigsci.API.
+func (m *Client) DeleteBlacklistIP(corpName string, siteName string, id string) error {
+	return m.DeleteBlacklistIPContext(context.Background(), corpName, siteName, id)
+}
+
+// DeleteBlacklistIPContext implements sigsci.API.
+func (m *Client) DeleteBlacklistIPContext(ctx context.Context, corpName string, siteName string, id string) (err error) {
+	m.record("DeleteBlacklistIP", corpName, siteName, id)
+	if m.DeleteBlacklistIPFunc != nil {
+		return m.DeleteBlacklistIPFunc(ctx, corpName, siteName, id)
+	}
+
+	return
+}
+
+// ListParams implements sigsci.API.
+func (m *Client) ListParams(corpName string, siteName string) ([]sigsci.Param, error) {
+	return m.ListParamsContext(context.Background(), corpName, siteName)
+}
+
+// ListParamsContext implements sigsci.API.
+func (m *Client) ListParamsContext(ctx context.Context, corpName string, siteName string) (r0 []sigsci.Param, err error) {
+	m.record("ListParams", corpName, siteName)
+	if m.ListParamsFunc != nil {
+		return m.ListParamsFunc(ctx, corpName, siteName)
+	}
+
+	return
+}
+
+// GetParam implements sigsci.API.
+func (m *Client) GetParam(corpName string, siteName string, id string) (sigsci.Param, error) {
+	return m.GetParamContext(context.Background(), corpName, siteName, id)
+}
+
+// GetParamContext implements sigsci.API.
+func (m *Client) GetParamContext(ctx context.Context, corpName string, siteName string, id string) (r0 sigsci.Param, err error) {
+	m.record("GetParam", corpName, siteName, id)
+	if m.GetParamFunc != nil {
+		return m.GetParamFunc(ctx, corpName, siteName, id)
+	}
+
+	return
+}
+
+// AddParam implements sigsci.API.
+func (m *Client) AddParam(corpName string, siteName string, body sigsci.ParamBody) (sigsci.Param, error) {
+	return m.AddParamContext(context.Background(), corpName, siteName, body)
+}
+
+// AddParamContext implements sigsci.API.
+func (m *Client) AddParamContext(ctx context.Context, corpName string, siteName string, body sigsci.ParamBody) (r0 sigsci.Param, err error) {
+	m.record("AddParam", corpName, siteName, body)
+	if m.AddParamFunc != nil {
+		return m.AddParamFunc(ctx, corpName, siteName, body)
+	}
+
+	return
+}
+
+// DeleteParam implements sigsci.API.
+func (m *Client) DeleteParam(corpName string, siteName string, id string) error {
+	return m.DeleteParamContext(context.Background(), corpName, siteName, id)
+}
+
+// DeleteParamContext implements sigsci.API.
+func (m *Client) DeleteParamContext(ctx context.Context, corpName string, siteName string, id string) (err error) {
+	m.record("DeleteParam", corpName, siteName, id)
+	if m.DeleteParamFunc != nil {
+		return m.DeleteParamFunc(ctx, corpName, siteName, id)
+	}
+
+	return
+}
+
+// ListPaths implements sigsci.API.
+func (m *Client) ListPaths(corpName string, siteName string) ([]sigsci.Path, error) {
+	return m.ListPathsContext(context.Background(), corpName, siteName)
+}
+
+// ListPathsContext implements sigsci.API.
+func (m *Client) ListPathsContext(ctx context.Context, corpName string, siteName string) (r0 []sigsci.Path, err error) {
+	m.record("ListPaths", corpName, siteName)
+	if m.ListPathsFunc != nil {
+		return m.ListPathsFunc(ctx, corpName, siteName)
+	}
+
+	return
+}
+
+// GetPath implements sigsci.API.
+func (m *Client) GetPath(corpName string, siteName string, id string) (sigsci.Path, error) {
+	return m.GetPathContext(context.Background(), corpName, siteName, id)
+}
+
+// GetPathContext implements sigsci.API.
+func (m *Client) GetPathContext(ctx context.Context, corpName string, siteName string, id string) (r0 sigsci.Path, err error) {
+	m.record("GetPath", corpName, siteName, id)
+	if m.GetPathFunc != nil {
+		return m.GetPathFunc(ctx, corpName, siteName, id)
+	}
+
+	return
+}
+
+// AddPath implements sigsci.API.
+func (m *Client) AddPath(corpName string, siteName string, body sigsci.PathBody) (sigsci.Path, error) {
+	return m.AddPathContext(context.Background(), corpName, siteName, body)
+}
+
+// AddPathContext implements sigsci.API.
+func (m *Client) AddPathContext(ctx context.Context, corpName string, siteName string, body sigsci.PathBody) (r0 sigsci.Path, err error) {
+	m.record("AddPath", corpName, siteName, body)
+	if m.AddPathFunc != nil {
+		return m.AddPathFunc(ctx, corpName, siteName, body)
+	}
+
+	return
+}
+
+// DeletePath implements sigsci.API.
+func (m *Client) DeletePath(corpName string, siteName string, id string) error {
+	return m.DeletePathContext(context.Background(), corpName, siteName, id)
+}
+
+// DeletePathContext implements sigsci.API.
+func (m *Client) DeletePathContext(ctx context.Context, corpName string, siteName string, id string) (err error) {
+	m.record("DeletePath", corpName, siteName, id)
+	if m.DeletePathFunc != nil {
+		return m.DeletePathFunc(ctx, corpName, siteName, id)
+	}
+
+	return
+}
+
+// ListCustomAlerts implements sigsci.API.
+func (m *Client) ListCustomAlerts(corpName string, siteName string) ([]sigsci.CustomAlert, error) {
+	return m.ListCustomAlertsContext(context.Background(), corpName, siteName)
+}
+
+// ListCustomAlertsContext implements sigsci.API.
+func (m *Client) ListCustomAlertsContext(ctx context.Context, corpName string, siteName string) (r0 []sigsci.CustomAlert, err error) {
+	m.record("ListCustomAlerts", corpName, siteName)
+	if m.ListCustomAlertsFunc != nil {
+		return m.ListCustomAlertsFunc(ctx, corpName, siteName)
+	}
+
+	return
+}
+
+// GetCustomAlert implements sigsci.API.
+func (m *Client) GetCustomAlert(corpName string, siteName string, id string) (sigsci.CustomAlert, error) {
+	return m.GetCustomAlertContext(context.Background(), corpName, siteName, id)
+}
+
+// GetCustomAlertContext implements sigsci.API.
+func (m *Client) GetCustomAlertContext(ctx context.Context, corpName string, siteName string, id string) (r0 sigsci.CustomAlert, err error) {
+	m.record("GetCustomAlert", corpName, siteName, id)
+	if m.GetCustomAlertFunc != nil {
+		return m.GetCustomAlertFunc(ctx, corpName, siteName, id)
+	}
+
+	return
+}
+
+// CreateCustomAlert implements sigsci.API.
+func (m *Client) CreateCustomAlert(corpName string, siteName string, body sigsci.CustomAlertBody) (sigsci.CustomAlert, error) {
+	return m.CreateCustomAlertContext(context.Background(), corpName, siteName, body)
+}
+
+// CreateCustomAlertContext implements sigsci.API.
+func (m *Client) CreateCustomAlertContext(ctx context.Context, corpName string, siteName string, body sigsci.CustomAlertBody) (r0 sigsci.CustomAlert, err error) {
+	m.record("CreateCustomAlert", corpName, siteName, body)
+	if m.CreateCustomAlertFunc != nil {
+		return m.CreateCustomAlertFunc(ctx, corpName, siteName, body)
+	}
+
+	return
+}
+
+// UpdateCustomAlert implements sigsci.API.
+func (m *Client) UpdateCustomAlert(corpName string, siteName string, id string, body sigsci.CustomAlertBody) (sigsci.CustomAlert, error) {
+	return m.UpdateCustomAlertContext(context.Background(), corpName, siteName, id, body)
+}
+
+// UpdateCustomAlertContext implements sigsci.API.
+func (m *Client) UpdateCustomAlertContext(ctx context.Context, corpName string, siteName string, id string, body sigsci.CustomAlertBody) (r0 sigsci.CustomAlert, err error) {
+	m.record("UpdateCustomAlert", corpName, siteName, id, body)
+	if m.UpdateCustomAlertFunc != nil {
+		return m.UpdateCustomAlertFunc(ctx, corpName, siteName, id, body)
+	}
+
+	return
+}
+
+// DeleteCustomAlert implements sigsci.API.
+func (m *Client) DeleteCustomAlert(corpName string, siteName string, id string) error {
+	return m.DeleteCustomAlertContext(context.Background(), corpName, siteName, id)
+}
+
+// DeleteCustomAlertContext implements sigsci.API.
+func (m *Client) DeleteCustomAlertContext(ctx context.Context, corpName string, siteName string, id string) (err error) {
+	m.record("DeleteCustomAlert", corpName, siteName, id)
+	if m.DeleteCustomAlertFunc != nil {
+		return m.DeleteCustomAlertFunc(ctx, corpName, siteName, id)
+	}
+
+	return
+}
+
+// ListIntegrations implements sigsci.API.
+func (m *Client) ListIntegrations(corpName string, siteName string) ([]sigsci.Integration, error) {
+	return m.ListIntegrationsContext(context.Background(), corpName, siteName)
+}
+
+// ListIntegrationsContext implements sigsci.API.
+func (m *Client) ListIntegrationsContext(ctx context.Context, corpName string, siteName string) (r0 []sigsci.Integration, err error) {
+	m.record("ListIntegrations", corpName, siteName)
+	if m.ListIntegrationsFunc != nil {
+		return m.ListIntegrationsFunc(ctx, corpName, siteName)
+	}
+
+	return
+}
+
+// GetIntegration implements sigsci.API.
+func (m *Client) GetIntegration(corpName string, siteName string, id string) (sigsci.Integration, error) {
+	return m.GetIntegrationContext(context.Background(), corpName, siteName, id)
+}
+
+// GetIntegrationContext implements sigsci.API.
+func (m *Client) GetIntegrationContext(ctx context.Context, corpName string, siteName string, id string) (r0 sigsci.Integration, err error) {
+	m.record("GetIntegration", corpName, siteName, id)
+	if m.GetIntegrationFunc != nil {
+		return m.GetIntegrationFunc(ctx, corpName, siteName, id)
+	}
+
+	return
+}
+
+// AddIntegration implements sigsci.API.
+func (m *Client) AddIntegration(corpName string, siteName string, body sigsci.IntegrationBody) ([]sigsci.Integration, error) {
+	return m.AddIntegrationContext(context.Background(), corpName, siteName, body)
+}
+
+// AddIntegrationContext implements sigsci.API.
+func (m *Client) AddIntegrationContext(ctx context.Context, corpName string, siteName string, body sigsci.IntegrationBody) (r0 []sigsci.Integration, err error) {
+	m.record("AddIntegration", corpName, siteName, body)
+	if m.AddIntegrationFunc != nil {
+		return m.AddIntegrationFunc(ctx, corpName, siteName, body)
+	}
+
+	return
+}
+
+// UpdateIntegration implements sigsci.API.
+func (m *Client) UpdateIntegration(corpName string, siteName string, id string, body sigsci.UpdateIntegrationBody) error {
+	return m.UpdateIntegrationContext(context.Background(), corpName, siteName, id, body)
+}
+
+// UpdateIntegrationContext implements sigsci.API.
+func (m *Client) UpdateIntegrationContext(ctx context.Context, corpName string, siteName string, id string, body sigsci.UpdateIntegrationBody) (err error) {
+	m.record("UpdateIntegration", corpName, siteName, id, body)
+	if m.UpdateIntegrationFunc != nil {
+		return m.UpdateIntegrationFunc(ctx, corpName, siteName, id, body)
+	}
+
+	return
+}
+
+// DeleteIntegration implements sigsci.API.
+func (m *Client) DeleteIntegration(corpName string, siteName string, id string) error {
+	return m.DeleteIntegrationContext(context.Background(), corpName, siteName, id)
+}
+
+// DeleteIntegrationContext implements sigsci.API.
+func (m *Client) DeleteIntegrationContext(ctx context.Context, corpName string, siteName string, id string) (err error) {
+	m.record("DeleteIntegration", corpName, siteName, id)
+	if m.DeleteIntegrationFunc != nil {
+		return m.DeleteIntegrationFunc(ctx, corpName, siteName, id)
+	}
+
+	return
+}
+
+// ListCorpIntegrations implements sigsci.API.
+func (m *Client) ListCorpIntegrations(corpName string) ([]sigsci.Integration, error) {
+	return m.ListCorpIntegrationsContext(context.Background(), corpName)
+}
+
+// ListCorpIntegrationsContext implements sigsci.API.
+func (m *Client) ListCorpIntegrationsContext(ctx context.Context, corpName string) (r0 []sigsci.Integration, err error) {
+	m.record("ListCorpIntegrations", corpName)
+	if m.ListCorpIntegrationsFunc != nil {
+		return m.ListCorpIntegrationsFunc(ctx, corpName)
+	}
+
+	return
+}
+
+// GetCorpIntegration implements sigsci.API.
+func (m *Client) GetCorpIntegration(corpName string, id string) (sigsci.Integration, error) {
+	return m.GetCorpIntegrationContext(context.Background(), corpName, id)
+}
+
+// GetCorpIntegrationContext implements sigsci.API.
+func (m *Client) GetCorpIntegrationContext(ctx context.Context, corpName string, id string) (r0 sigsci.Integration, err error) {
+	m.record("GetCorpIntegration", corpName, id)
+	if m.GetCorpIntegrationFunc != nil {
+		return m.GetCorpIntegrationFunc(ctx, corpName, id)
+	}
+
+	return
+}
+
+// AddCorpIntegration implements sigsci.API.
+func (m *Client) AddCorpIntegration(corpName string, body sigsci.IntegrationBody) ([]sigsci.Integration, error) {
+	return m.AddCorpIntegrationContext(context.Background(), corpName, body)
+}
+
+// AddCorpIntegrationContext implements sigsci.API.
+func (m *Client) AddCorpIntegrationContext(ctx context.Context, corpName string, body sigsci.IntegrationBody) (r0 []sigsci.Integration, err error) {
+	m.record("AddCorpIntegration", corpName, body)
+	if m.AddCorpIntegrationFunc != nil {
+		return m.AddCorpIntegrationFunc(ctx, corpName, body)
+	}
+
+	return
+}
+
+// UpdateCorpIntegration implements sigsci.API.
+func (m *Client) UpdateCorpIntegration(corpName string, id string, body sigsci.UpdateIntegrationBody) error {
+	return m.UpdateCorpIntegrationContext(context.Background(), corpName, id, body)
+}
+
+// UpdateCorpIntegrationContext implements sigsci.API.
+func (m *Client) UpdateCorpIntegrationContext(ctx context.Context, corpName string, id string, body sigsci.UpdateIntegrationBody) (err error) {
+	m.record("UpdateCorpIntegration", corpName, id, body)
+	if m.UpdateCorpIntegrationFunc != nil {
+		return m.UpdateCorpIntegrationFunc(ctx, corpName, id, body)
+	}
+
+	return
+}
+
+// DeleteCorpIntegration implements sigsci.API.
+func (m *Client) DeleteCorpIntegration(corpName string, id string) error {
+	return m.DeleteCorpIntegrationContext(context.Background(), corpName, id)
+}
+
+// DeleteCorpIntegrationContext implements sigsci.API.
+func (m *Client) DeleteCorpIntegrationContext(ctx context.Context, corpName string, id string) (err error) {
+	m.record("DeleteCorpIntegration", corpName, id)
+	if m.DeleteCorpIntegrationFunc != nil {
+		return m.DeleteCorpIntegrationFunc(ctx, corpName, id)
+	}
+
+	return
+}
+
+// ListRules implements sigsci.API.
+func (m *Client) ListRules(corpName string, siteName string) ([]sigsci.Rule, error) {
+	return m.ListRulesContext(context.Background(), corpName, siteName)
+}
+
+// ListRulesContext implements sigsci.API.
+func (m *Client) ListRulesContext(ctx context.Context, corpName string, siteName string) (r0 []sigsci.Rule, err error) {
+	m.record("ListRules", corpName, siteName)
+	if m.ListRulesFunc != nil {
+		return m.ListRulesFunc(ctx, corpName, siteName)
+	}
+
+	return
+}
+
+// GetRule implements sigsci.API.
+func (m *Client) GetRule(corpName string, siteName string, id string) (sigsci.Rule, error) {
+	return m.GetRuleContext(context.Background(), corpName, siteName, id)
+}
+
+// GetRuleContext implements sigsci.API.
+func (m *Client) GetRuleContext(ctx context.Context, corpName string, siteName string, id string) (r0 sigsci.Rule, err error) {
+	m.record("GetRule", corpName, siteName, id)
+	if m.GetRuleFunc != nil {
+		return m.GetRuleFunc(ctx, corpName, siteName, id)
+	}
+
+	return
+}
+
+// CreateRule implements sigsci.API.
+func (m *Client) CreateRule(corpName string, siteName string, body sigsci.RuleBody) (sigsci.Rule, error) {
+	return m.CreateRuleContext(context.Background(), corpName, siteName, body)
+}
+
+// CreateRuleContext implements sigsci.API.
+func (m *Client) CreateRuleContext(ctx context.Context, corpName string, siteName string, body sigsci.RuleBody) (r0 sigsci.Rule, err error) {
+	m.record("CreateRule", corpName, siteName, body)
+	if m.CreateRuleFunc != nil {
+		return m.CreateRuleFunc(ctx, corpName, siteName, body)
+	}
+
+	return
+}
+
+// UpdateRule implements sigsci.API.
+func (m *Client) UpdateRule(corpName string, siteName string, id string, body sigsci.RuleBody) (sigsci.Rule, error) {
+	return m.UpdateRuleContext(context.Background(), corpName, siteName, id, body)
+}
+
+// UpdateRuleContext implements sigsci.API.
+func (m *Client) UpdateRuleContext(ctx context.Context, corpName string, siteName string, id string, body sigsci.RuleBody) (r0 sigsci.Rule, err error) {
+	m.record("UpdateRule", corpName, siteName, id, body)
+	if m.UpdateRuleFunc != nil {
+		return m.UpdateRuleFunc(ctx, corpName, siteName, id, body)
+	}
+
+	return
+}
+
+// DeleteRule implements sigsci.API.
+func (m *Client) DeleteRule(corpName string, siteName string, id string) error {
+	return m.DeleteRuleContext(context.Background(), corpName, siteName, id)
+}
+
+// DeleteRuleContext implements sigsci.API.
+func (m *Client) DeleteRuleContext(ctx context.Context, corpName string, siteName string, id string) (err error) {
+	m.record("DeleteRule", corpName, siteName, id)
+	if m.DeleteRuleFunc != nil {
+		return m.DeleteRuleFunc(ctx, corpName, siteName, id)
+	}
+
+	return
+}
+
+// ListRateLimitRules implements sigsci.API.
+func (m *Client) ListRateLimitRules(corpName string, siteName string) ([]sigsci.Rule, error) {
+	return m.ListRateLimitRulesContext(context.Background(), corpName, siteName)
+}
+
+// ListRateLimitRulesContext implements sigsci.API.
+func (m *Client) ListRateLimitRulesContext(ctx context.Context, corpName string, siteName string) (r0 []sigsci.Rule, err error) {
+	m.record("ListRateLimitRules", corpName, siteName)
+	if m.ListRateLimitRulesFunc != nil {
+		return m.ListRateLimitRulesFunc(ctx, corpName, siteName)
+	}
+
+	return
+}
+
+// ListCorpRules implements sigsci.API.
+func (m *Client) ListCorpRules(corpName string) ([]sigsci.CorpRule, error) {
+	return m.ListCorpRulesContext(context.Background(), corpName)
+}
+
+// ListCorpRulesContext implements sigsci.API.
+func (m *Client) ListCorpRulesContext(ctx context.Context, corpName string) (r0 []sigsci.CorpRule, err error) {
+	m.record("ListCorpRules", corpName)
+	if m.ListCorpRulesFunc != nil {
+		return m.ListCorpRulesFunc(ctx, corpName)
+	}
+
+	return
+}
+
+// GetCorpRule implements sigsci.API.
+func (m *Client) GetCorpRule(corpName string, id string) (sigsci.CorpRule, error) {
+	return m.GetCorpRuleContext(context.Background(), corpName, id)
+}
+
+// GetCorpRuleContext implements sigsci.API.
+func (m *Client) GetCorpRuleContext(ctx context.Context, corpName string, id string) (r0 sigsci.CorpRule, err error) {
+	m.record("GetCorpRule", corpName, id)
+	if m.GetCorpRuleFunc != nil {
+		return m.GetCorpRuleFunc(ctx, corpName, id)
+	}
+
+	return
+}
+
+// CreateCorpRule implements sigsci.API.
+func (m *Client) CreateCorpRule(corpName string, body sigsci.CorpRuleBody) (sigsci.CorpRule, error) {
+	return m.CreateCorpRuleContext(context.Background(), corpName, body)
+}
+
+// CreateCorpRuleContext implements sigsci.API.
+func (m *Client) CreateCorpRuleContext(ctx context.Context, corpName string, body sigsci.CorpRuleBody) (r0 sigsci.CorpRule, err error) {
+	m.record("CreateCorpRule", corpName, body)
+	if m.CreateCorpRuleFunc != nil {
+		return m.CreateCorpRuleFunc(ctx, corpName, body)
+	}
+
+	return
+}
+
+// UpdateCorpRule implements sigsci.API.
+func (m *Client) UpdateCorpRule(corpName string, id string, body sigsci.CorpRuleBody) (sigsci.CorpRule, error) {
+	return m.UpdateCorpRuleContext(context.Background(), corpName, id, body)
+}
+
+// UpdateCorpRuleContext implements sigsci.API.
+func (m *Client) UpdateCorpRuleContext(ctx context.Context, corpName string, id string, body sigsci.CorpRuleBody) (r0 sigsci.CorpRule, err error) {
+	m.record("UpdateCorpRule", corpName, id, body)
+	if m.UpdateCorpRuleFunc != nil {
+		return m.UpdateCorpRuleFunc(ctx, corpName, id, body)
+	}
+
+	return
+}
+
+// DeleteCorpRule implements sigsci.API.
+func (m *Client) DeleteCorpRule(corpName string, id string) error {
+	return m.DeleteCorpRuleContext(context.Background(), corpName, id)
+}
+
+// DeleteCorpRuleContext implements sigsci.API.
+func (m *Client) DeleteCorpRuleContext(ctx context.Context, corpName string, id string) (err error) {
+	m.record("DeleteCorpRule", corpName, id)
+	if m.DeleteCorpRuleFunc != nil {
+		return m.DeleteCorpRuleFunc(ctx, corpName, id)
+	}
+
+	return
+}
+
+// ListTemplatedRules implements sigsci.API.
+func (m *Client) ListTemplatedRules(corpName string, siteName string) ([]sigsci.TemplatedRule, error) {
+	return m.ListTemplatedRulesContext(context.Background(), corpName, siteName)
+}
+
+// ListTemplatedRulesContext implements sigsci.API.
+func (m *Client) ListTemplatedRulesContext(ctx context.Context, corpName string, siteName string) (r0 []sigsci.TemplatedRule, err error) {
+	m.record("ListTemplatedRules", corpName, siteName)
+	if m.ListTemplatedRulesFunc != nil {
+		return m.ListTemplatedRulesFunc(ctx, corpName, siteName)
+	}
+
+	return
+}
+
+// GetTemplatedRule implements sigsci.API.
+func (m *Client) GetTemplatedRule(corpName string, siteName string, name string) (sigsci.TemplatedRule, error) {
+	return m.GetTemplatedRuleContext(context.Background(), corpName, siteName, name)
+}
+
+// GetTemplatedRuleContext implements sigsci.API.
+func (m *Client) GetTemplatedRuleContext(ctx context.Context, corpName string, siteName string, name string) (r0 sigsci.TemplatedRule, err error) {
+	m.record("GetTemplatedRule", corpName, siteName, name)
+	if m.GetTemplatedRuleFunc != nil {
+		return m.GetTemplatedRuleFunc(ctx, corpName, siteName, name)
+	}
+
+	return
+}
+
+// UpdateTemplatedRule implements sigsci.API.
+func (m *Client) UpdateTemplatedRule(corpName string, siteName string, name string, body sigsci.UpdateTemplatedRuleBody) (sigsci.TemplatedRule, error) {
+	return m.UpdateTemplatedRuleContext(context.Background(), corpName, siteName, name, body)
+}
+
+// UpdateTemplatedRuleContext implements sigsci.API.
+func (m *Client) UpdateTemplatedRuleContext(ctx context.Context, corpName string, siteName string, name string, body sigsci.UpdateTemplatedRuleBody) (r0 sigsci.TemplatedRule, err error) {
+	m.record("UpdateTemplatedRule", corpName, siteName, name, body)
+	if m.UpdateTemplatedRuleFunc != nil {
+		return m.UpdateTemplatedRuleFunc(ctx, corpName, siteName, name, body)
+	}
+
+	return
+}
+
+// ListSiteSignals implements sigsci.API.
+func (m *Client) ListSiteSignals(corpName string, siteName string) ([]sigsci.Signal, error) {
+	return m.ListSiteSignalsContext(context.Background(), corpName, siteName)
+}
+
+// ListSiteSignalsContext implements sigsci.API.
+func (m *Client) ListSiteSignalsContext(ctx context.Context, corpName string, siteName string) (r0 []sigsci.Signal, err error) {
+	m.record("ListSiteSignals", corpName, siteName)
+	if m.ListSiteSignalsFunc != nil {
+		return m.ListSiteSignalsFunc(ctx, corpName, siteName)
+	}
+
+	return
+}
+
+// GetSiteSignal implements sigsci.API.
+func (m *Client) GetSiteSignal(corpName string, siteName string, tagName string) (sigsci.Signal, error) {
+	return m.GetSiteSignalContext(context.Background(), corpName, siteName, tagName)
+}
+
+// GetSiteSignalContext implements sigsci.API.
+func (m *Client) GetSiteSignalContext(ctx context.Context, corpName string, siteName string, tagName string) (r0 sigsci.Signal, err error) {
+	m.record("GetSiteSignal", corpName, siteName, tagName)
+	if m.GetSiteSignalFunc != nil {
+		return m.GetSiteSignalFunc(ctx, corpName, siteName, tagName)
+	}
+
+	return
+}
+
+// CreateSiteSignal implements sigsci.API.
+func (m *Client) CreateSiteSignal(corpName string, siteName string, body sigsci.CreateSignalBody) (sigsci.Signal, error) {
+	return m.CreateSiteSignalContext(context.Background(), corpName, siteName, body)
+}
+
+// CreateSiteSignalContext implements sigsci.API.
+func (m *Client) CreateSiteSignalContext(ctx context.Context, corpName string, siteName string, body sigsci.CreateSignalBody) (r0 sigsci.Signal, err error) {
+	m.record("CreateSiteSignal", corpName, siteName, body)
+	if m.CreateSiteSignalFunc != nil {
+		return m.CreateSiteSignalFunc(ctx, corpName, siteName, body)
+	}
+
+	return
+}
+
+// UpdateSiteSignal implements sigsci.API.
+func (m *Client) UpdateSiteSignal(corpName string, siteName string, tagName string, body sigsci.UpdateSignalBody) (sigsci.Signal, error) {
+	return m.UpdateSiteSignalContext(context.Background(), corpName, siteName, tagName, body)
+}
+
+// UpdateSiteSignalContext implements sigsci.API.
+func (m *Client) UpdateSiteSignalContext(ctx context.Context, corpName string, siteName string, tagName string, body sigsci.UpdateSignalBody) (r0 sigsci.Signal, err error) {
+	m.record("UpdateSiteSignal", corpName, siteName, tagName, body)
+	if m.UpdateSiteSignalFunc != nil {
+		return m.UpdateSiteSignalFunc(ctx, corpName, siteName, tagName, body)
+	}
+
+	return
+}
+
+// DeleteSiteSignal implements sigsci.API.
+func (m *Client) DeleteSiteSignal(corpName string, siteName string, tagName string) error {
+	return m.DeleteSiteSignalContext(context.Background(), corpName, siteName, tagName)
+}
+
+// DeleteSiteSignalContext implements sigsci.API.
+func (m *Client) DeleteSiteSignalContext(ctx context.Context, corpName string, siteName string, tagName string) (err error) {
+	m.record("DeleteSiteSignal", corpName, siteName, tagName)
+	if m.DeleteSiteSignalFunc != nil {
+		return m.DeleteSiteSignalFunc(ctx, corpName, siteName, tagName)
+	}
+
+	return
+}
+
+// ListCorpSignals implements sigsci.API.
+func (m *Client) ListCorpSignals(corpName string) ([]sigsci.Signal, error) {
+	return m.ListCorpSignalsContext(context.Background(), corpName)
+}
+
+// ListCorpSignalsContext implements sigsci.API.
+func (m *Client) ListCorpSignalsContext(ctx context.Context, corpName string) (r0 []sigsci.Signal, err error) {
+	m.record("ListCorpSignals", corpName)
+	if m.ListCorpSignalsFunc != nil {
+		return m.ListCorpSignalsFunc(ctx, corpName)
+	}
+
+	return
+}
+
+// GetCorpSignal implements sigsci.API.
+func (m *Client) GetCorpSignal(corpName string, tagName string) (sigsci.Signal, error) {
+	return m.GetCorpSignalContext(context.Background(), corpName, tagName)
+}
+
+// GetCorpSignalContext implements sigsci.API.
+func (m *Client) GetCorpSignalContext(ctx context.Context, corpName string, tagName string) (r0 sigsci.Signal, err error) {
+	m.record("GetCorpSignal", corpName, tagName)
+	if m.GetCorpSignalFunc != nil {
+		return m.GetCorpSignalFunc(ctx, corpName, tagName)
+	}
+
+	return
+}
+
+// CreateCorpSignal implements sigsci.API.
+func (m *Client) CreateCorpSignal(corpName string, body sigsci.CreateSignalBody) (sigsci.Signal, error) {
+	return m.CreateCorpSignalContext(context.Background(), corpName, body)
+}
+
+// CreateCorpSignalContext implements sigsci.API.
+func (m *Client) CreateCorpSignalContext(ctx context.Context, corpName string, body sigsci.CreateSignalBody) (r0 sigsci.Signal, err error) {
+	m.record("CreateCorpSignal", corpName, body)
+	if m.CreateCorpSignalFunc != nil {
+		return m.CreateCorpSignalFunc(ctx, corpName, body)
+	}
+
+	return
+}
+
+// UpdateCorpSignal implements sigsci.API.
+func (m *Client) UpdateCorpSignal(corpName string, tagName string, body sigsci.UpdateSignalBody) (sigsci.Signal, error) {
+	return m.UpdateCorpSignalContext(context.Background(), corpName, tagName, body)
+}
+
+// UpdateCorpSignalContext implements sigsci.API.
+func (m *Client) UpdateCorpSignalContext(ctx context.Context, corpName string, tagName string, body sigsci.UpdateSignalBody) (r0 sigsci.Signal, err error) {
+	m.record("UpdateCorpSignal", corpName, tagName, body)
+	if m.UpdateCorpSignalFunc != nil {
+		return m.UpdateCorpSignalFunc(ctx, corpName, tagName, body)
+	}
+
+	return
+}
+
+// DeleteCorpSignal implements sigsci.API.
+func (m *Client) DeleteCorpSignal(corpName string, tagName string) error {
+	return m.DeleteCorpSignalContext(context.Background(), corpName, tagName)
+}
+
+// DeleteCorpSignalContext implements sigsci.API.
+func (m *Client) DeleteCorpSignalContext(ctx context.Context, corpName string, tagName string) (err error) {
+	m.record("DeleteCorpSignal", corpName, tagName)
+	if m.DeleteCorpSignalFunc != nil {
+		return m.DeleteCorpSignalFunc(ctx, corpName, tagName)
+	}
+
+	return
+}
+
+// ListSiteLists implements sigsci.API.
+func (m *Client) ListSiteLists(corpName string, siteName string) ([]sigsci.List, error) {
+	return m.ListSiteListsContext(context.Background(), corpName, siteName)
+}
+
+// ListSiteListsContext implements sigsci.API.
+func (m *Client) ListSiteListsContext(ctx context.Context, corpName string, siteName string) (r0 []sigsci.List, err error) {
+	m.record("ListSiteLists", corpName, siteName)
+	if m.ListSiteListsFunc != nil {
+		return m.ListSiteListsFunc(ctx, corpName, siteName)
+	}
+
+	return
+}
+
+// GetSiteList implements sigsci.API.
+func (m *Client) GetSiteList(corpName string, siteName string, id string) (sigsci.List, error) {
+	return m.GetSiteListContext(context.Background(), corpName, siteName, id)
+}
+
+// GetSiteListContext implements sigsci.API.
+func (m *Client) GetSiteListContext(ctx context.Context, corpName string, siteName string, id string) (r0 sigsci.List, err error) {
+	m.record("GetSiteList", corpName, siteName, id)
+	if m.GetSiteListFunc != nil {
+		return m.GetSiteListFunc(ctx, corpName, siteName, id)
+	}
+
+	return
+}
+
+// CreateSiteList implements sigsci.API.
+func (m *Client) CreateSiteList(corpName string, siteName string, body sigsci.CreateListBody) (sigsci.List, error) {
+	return m.CreateSiteListContext(context.Background(), corpName, siteName, body)
+}
+
+// CreateSiteListContext implements sigsci.API.
+func (m *Client) CreateSiteListContext(ctx context.Context, corpName string, siteName string, body sigsci.CreateListBody) (r0 sigsci.List, err error) {
+	m.record("CreateSiteList", corpName, siteName, body)
+	if m.CreateSiteListFunc != nil {
+		return m.CreateSiteListFunc(ctx, corpName, siteName, body)
+	}
+
+	return
+}
+
+// UpdateSiteList implements sigsci.API.
+func (m *Client) UpdateSiteList(corpName string, siteName string, id string, body sigsci.UpdateListBody) (sigsci.List, error) {
+	return m.UpdateSiteListContext(context.Background(), corpName, siteName, id, body)
+}
+
+// UpdateSiteListContext implements sigsci.API.
+func (m *Client) UpdateSiteListContext(ctx context.Context, corpName string, siteName string, id string, body sigsci.UpdateListBody) (r0 sigsci.List, err error) {
+	m.record("UpdateSiteList", corpName, siteName, id, body)
+	if m.UpdateSiteListFunc != nil {
+		return m.UpdateSiteListFunc(ctx, corpName, siteName, id, body)
+	}
+
+	return
+}
+
+// DeleteSiteList implements sigsci.API.
+func (m *Client) DeleteSiteList(corpName string, siteName string, id string) error {
+	return m.DeleteSiteListContext(context.Background(), corpName, siteName, id)
+}
+
+// DeleteSiteListContext implements sigsci.API.
+func (m *Client) DeleteSiteListContext(ctx context.Context, corpName string, siteName string, id string) (err error) {
+	m.record("DeleteSiteList", corpName, siteName, id)
+	if m.DeleteSiteListFunc != nil {
+		return m.DeleteSiteListFunc(ctx, corpName, siteName, id)
+	}
+
+	return
+}
+
+// AddSiteListEntries implements sigsci.API.
+func (m *Client) AddSiteListEntries(corpName string, siteName string, id string, entries []string) (sigsci.List, error) {
+	return m.AddSiteListEntriesContext(context.Background(), corpName, siteName, id, entries)
+}
+
+// AddSiteListEntriesContext implements sigsci.API.
+func (m *Client) AddSiteListEntriesContext(ctx context.Context, corpName string, siteName string, id string, entries []string) (r0 sigsci.List, err error) {
+	m.record("AddSiteListEntries", corpName, siteName, id, entries)
+	if m.AddSiteListEntriesFunc != nil {
+		return m.AddSiteListEntriesFunc(ctx, corpName, siteName, id, entries)
+	}
+
+	return
+}
+
+// RemoveSiteListEntries implements sigsci.API.
+func (m *Client) RemoveSiteListEntries(corpName string, siteName string, id string, entries []string) (sigsci.List, error) {
+	return m.RemoveSiteListEntriesContext(context.Background(), corpName, siteName, id, entries)
+}
+
+// RemoveSiteListEntriesContext implements sigsci.API.
+func (m *Client) RemoveSiteListEntriesContext(ctx context.Context, corpName string, siteName string, id string, entries []string) (r0 sigsci.List, err error) {
+	m.record("RemoveSiteListEntries", corpName, siteName, id, entries)
+	if m.RemoveSiteListEntriesFunc != nil {
+		return m.RemoveSiteListEntriesFunc(ctx, corpName, siteName, id, entries)
+	}
+
+	return
+}
+
+// ListCorpLists implements sigsci.API.
+func (m *Client) ListCorpLists(corpName string) ([]sigsci.List, error) {
+	return m.ListCorpListsContext(context.Background(), corpName)
+}
+
+// ListCorpListsContext implements sigsci.API.
+func (m *Client) ListCorpListsContext(ctx context.Context, corpName string) (r0 []sigsci.List, err error) {
+	m.record("ListCorpLists", corpName)
+	if m.ListCorpListsFunc != nil {
+		return m.ListCorpListsFunc(ctx, corpName)
+	}
+
+	return
+}
+
+// GetCorpList implements sigsci.API.
+func (m *Client) GetCorpList(corpName string, id string) (sigsci.List, error) {
+	return m.GetCorpListContext(context.Background(), corpName, id)
+}
+
+// GetCorpListContext implements sigsci.API.
+func (m *Client) GetCorpListContext(ctx context.Context, corpName string, id string) (r0 sigsci.List, err error) {
+	m.record("GetCorpList", corpName, id)
+	if m.GetCorpListFunc != nil {
+		return m.GetCorpListFunc(ctx, corpName, id)
+	}
+
+	return
+}
+
+// CreateCorpList implements sigsci.API.
+func (m *Client) CreateCorpList(corpName string, body sigsci.CreateListBody) (sigsci.List, error) {
+	return m.CreateCorpListContext(context.Background(), corpName, body)
+}
+
+// CreateCorpListContext implements sigsci.API.
+func (m *Client) CreateCorpListContext(ctx context.Context, corpName string, body sigsci.CreateListBody) (r0 sigsci.List, err error) {
+	m.record("CreateCorpList", corpName, body)
+	if m.CreateCorpListFunc != nil {
+		return m.CreateCorpListFunc(ctx, corpName, body)
+	}
+
+	return
+}
+
+// UpdateCorpList implements sigsci.API.
+func (m *Client) UpdateCorpList(corpName string, id string, body sigsci.UpdateListBody) (sigsci.List, error) {
+	return m.UpdateCorpListContext(context.Background(), corpName, id, body)
+}
+
+// UpdateCorpListContext implements sigsci.API.
+func (m *Client) UpdateCorpListContext(ctx context.Context, corpName string, id string, body sigsci.UpdateListBody) (r0 sigsci.List, err error) {
+	m.record("UpdateCorpList", corpName, id, body)
+	if m.UpdateCorpListFunc != nil {
+		return m.UpdateCorpListFunc(ctx, corpName, id, body)
+	}
+
+	return
+}
+
+// DeleteCorpList implements sigsci.API.
+func (m *Client) DeleteCorpList(corpName string, id string) error {
+	return m.DeleteCorpListContext(context.Background(), corpName, id)
+}
+
+// DeleteCorpListContext implements sigsci.API.
+func (m *Client) DeleteCorpListContext(ctx context.Context, corpName string, id string) (err error) {
+	m.record("DeleteCorpList", corpName, id)
+	if m.DeleteCorpListFunc != nil {
+		return m.DeleteCorpListFunc(ctx, corpName, id)
+	}
+
+	return
+}
+
+// AddCorpListEntries implements sigsci.API.
+func (m *Client) AddCorpListEntries(corpName string, id string, entries []string) (sigsci.List, error) {
+	return m.AddCorpListEntriesContext(context.Background(), corpName, id, entries)
+}
+
+// AddCorpListEntriesContext implements sigsci.API.
+func (m *Client) AddCorpListEntriesContext(ctx context.Context, corpName string, id string, entries []string) (r0 sigsci.List, err error) {
+	m.record("AddCorpListEntries", corpName, id, entries)
+	if m.AddCorpListEntriesFunc != nil {
+		return m.AddCorpListEntriesFunc(ctx, corpName, id, entries)
+	}
+
+	return
+}
+
+// RemoveCorpListEntries implements sigsci.API.
+func (m *Client) RemoveCorpListEntries(corpName string, id string, entries []string) (sigsci.List, error) {
+	return m.RemoveCorpListEntriesContext(context.Background(), corpName, id, entries)
+}
+
+// RemoveCorpListEntriesContext implements sigsci.API.
+func (m *Client) RemoveCorpListEntriesContext(ctx context.Context, corpName string, id string, entries []string) (r0 sigsci.List, err error) {
+	m.record("RemoveCorpListEntries", corpName, id, entries)
+	if m.RemoveCorpListEntriesFunc != nil {
+		return m.RemoveCorpListEntriesFunc(ctx, corpName, id, entries)
+	}
+
+	return
+}
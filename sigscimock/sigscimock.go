@@ -0,0 +1,65 @@
+// Package sigscimock provides a mock implementation of the sigsci API
+// interfaces for unit tests.
+//
+// Set the Func fields for the methods the code under test calls, and
+// inspect the recorded calls afterwards:
+//
+//	m := &sigscimock.Client{
+//		ListSitesFunc: func(ctx context.Context, corpName string) ([]sigsci.Site, error) {
+//			return []sigsci.Site{{Name: "www.mysite.com"}}, nil
+//		},
+//	}
+//
+//	sites, err := m.ListSites("testcorp")
+//	calls := m.Calls() // [{ListSites [testcorp]}]
+//
+// For tests that exercise the HTTP layer, see the sigscitest package.
+package sigscimock
+
+//go:generate go run gen.go
+
+// Call is a recorded method call. A method and its Context variant are
+// recorded under the name of the method without the Context suffix, and
+// Args does not include the context.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// Calls returns the calls made so far, in order.
+func (m *Client) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]Call(nil), m.calls...)
+}
+
+// CallCount returns the number of calls made to a method.
+func (m *Client) CallCount(method string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var n int
+	for _, c := range m.calls {
+		if c.Method == method {
+			n++
+		}
+	}
+
+	return n
+}
+
+// ResetCalls forgets the calls made so far.
+func (m *Client) ResetCalls() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.calls = nil
+}
+
+func (m *Client) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
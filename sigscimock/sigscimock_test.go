@@ -0,0 +1,53 @@
+package sigscimock
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	sigsci "github.com/signalsciences/go-sigsci"
+)
+
+func TestClient(t *testing.T) {
+	errBoom := errors.New("boom")
+	m := &Client{
+		GetSiteFunc: func(ctx context.Context, corpName, siteName string) (sigsci.Site, error) {
+			return sigsci.Site{Name: siteName}, nil
+		},
+		DeleteSiteFunc: func(ctx context.Context, corpName, siteName string) error {
+			return errBoom
+		},
+	}
+
+	var api sigsci.SiteAPI = m
+
+	site, err := api.GetSite("testcorp", "www.mysite.com")
+	if err != nil || site.Name != "www.mysite.com" {
+		t.Fatalf("GetSite = %+v, %v", site, err)
+	}
+	if err := api.DeleteSiteContext(context.Background(), "testcorp", "www.mysite.com"); err != errBoom {
+		t.Fatalf("DeleteSiteContext error = %v, want %v", err, errBoom)
+	}
+	sites, err := api.ListSites("testcorp")
+	if sites != nil || err != nil {
+		t.Fatalf("ListSites = %v, %v, want zero values", sites, err)
+	}
+
+	want := []Call{
+		{Method: "GetSite", Args: []interface{}{"testcorp", "www.mysite.com"}},
+		{Method: "DeleteSite", Args: []interface{}{"testcorp", "www.mysite.com"}},
+		{Method: "ListSites", Args: []interface{}{"testcorp"}},
+	}
+	if got := m.Calls(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Calls = %+v, want %+v", got, want)
+	}
+	if n := m.CallCount("GetSite"); n != 1 {
+		t.Errorf("CallCount(GetSite) = %d, want 1", n)
+	}
+
+	m.ResetCalls()
+	if calls := m.Calls(); len(calls) != 0 {
+		t.Errorf("Calls after ResetCalls = %+v, want none", calls)
+	}
+}
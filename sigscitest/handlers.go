@@ -0,0 +1,589 @@
+package sigscitest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	sigsci "github.com/signalsciences/go-sigsci"
+)
+
+// defaultLimit is the page size of paginated endpoints when the request
+// does not set a limit.
+const defaultLimit = 100
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"message": message})
+}
+
+func writeNotFound(w http.ResponseWriter) {
+	writeError(w, http.StatusNotFound, "Not found")
+}
+
+func writeMethodNotAllowed(w http.ResponseWriter) {
+	writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+}
+
+type dataResponse struct {
+	Data interface{} `json:"data"`
+}
+
+type pageResponse struct {
+	TotalCount int               `json:"totalCount"`
+	Next       map[string]string `json:"next"`
+	Data       interface{}       `json:"data"`
+}
+
+// page returns the bounds of the requested page of n items and the next
+// URI, which is empty on the last page.
+func page(r *http.Request, n int) (start, end int, next string) {
+	q := r.URL.Query()
+	limit, _ := strconv.Atoi(q.Get("limit"))
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	p, _ := strconv.Atoi(q.Get("page"))
+	if p <= 0 {
+		p = 1
+	}
+
+	start = (p - 1) * limit
+	if start > n {
+		start = n
+	}
+	end = start + limit
+	if end > n {
+		end = n
+	}
+
+	if end < n {
+		nq := url.Values{}
+		for k, v := range q {
+			nq[k] = v
+		}
+		nq.Set("limit", strconv.Itoa(limit))
+		nq.Set("page", strconv.Itoa(p+1))
+		next = r.URL.Path + "?" + nq.Encode()
+	}
+
+	return start, end, next
+}
+
+func (s *Server) handleAuth(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		writeMethodNotAllowed(w)
+		return
+	}
+
+	if r.FormValue("email") == "" || r.FormValue("password") == "" {
+		writeError(w, http.StatusUnauthorized, "Invalid email or password")
+		return
+	}
+
+	s.mu.Lock()
+	s.sessionExpired = false
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, map[string]string{"token": Token})
+}
+
+func (s *Server) handleLogout(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		writeMethodNotAllowed(w)
+		return
+	}
+
+	s.ExpireSession()
+	writeJSON(w, http.StatusOK, struct{}{})
+}
+
+// route dispatches an authenticated request by its API path.
+func (s *Server) route(w http.ResponseWriter, r *http.Request, path string) {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(parts) < 2 || parts[0] != "v0" || parts[1] != "corps" {
+		writeNotFound(w)
+		return
+	}
+	parts = parts[2:]
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(parts) == 0 {
+		s.handleCorps(w, r)
+		return
+	}
+
+	c, ok := s.corps[parts[0]]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+
+	switch {
+	case len(parts) == 1:
+		s.handleCorp(w, r, c)
+	case len(parts) == 2 && parts[1] == "sites":
+		s.handleSites(w, r, c)
+	case len(parts) >= 3 && parts[1] == "sites":
+		st, ok := c.sites[parts[2]]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		s.routeSite(w, r, c, st, parts[3:])
+	default:
+		writeNotFound(w)
+	}
+}
+
+// routeSite dispatches a request below /v0/corps/{corp}/sites/{site}.
+func (s *Server) routeSite(w http.ResponseWriter, r *http.Request, c *corp, st *site, parts []string) {
+	if len(parts) == 0 {
+		s.handleSite(w, r, c, st)
+		return
+	}
+
+	var id string
+	if len(parts) > 1 {
+		id = parts[1]
+	}
+
+	switch {
+	case parts[0] == "agents" && len(parts) <= 2:
+		s.handleAgents(w, r, st, id)
+	case parts[0] == "events" && len(parts) <= 2:
+		s.handleEvents(w, r, st, id)
+	case parts[0] == "events" && len(parts) == 3 && parts[2] == "expire":
+		s.handleExpireEvent(w, r, st, id)
+	case parts[0] == "requests" && len(parts) <= 2:
+		s.handleRequests(w, r, st, id)
+	case parts[0] == "feed" && len(parts) == 2 && parts[1] == "requests":
+		s.handleFeed(w, r, st)
+	case parts[0] == "whitelist" && len(parts) <= 2:
+		s.handleIPList(w, r, &st.whitelist, id)
+	case parts[0] == "blacklist" && len(parts) <= 2:
+		s.handleIPList(w, r, &st.blacklist, id)
+	case parts[0] == "redactions" && len(parts) <= 2:
+		s.handleRedactions(w, r, st, id)
+	case parts[0] == "integrations" && len(parts) <= 2:
+		s.handleIntegrations(w, r, st, id)
+	default:
+		writeNotFound(w)
+	}
+}
+
+func (s *Server) handleCorps(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		writeMethodNotAllowed(w)
+		return
+	}
+
+	corps := []sigsci.Corp{}
+	for _, c := range s.corps {
+		corps = append(corps, c.Corp)
+	}
+	sort.Slice(corps, func(i, j int) bool { return corps[i].Name < corps[j].Name })
+
+	writeJSON(w, http.StatusOK, dataResponse{corps})
+}
+
+func (s *Server) handleCorp(w http.ResponseWriter, r *http.Request, c *corp) {
+	switch r.Method {
+	case "GET":
+	case "PATCH":
+		var body sigsci.UpdateCorpBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if body.DisplayName != nil {
+			c.DisplayName = *body.DisplayName
+		}
+		if body.SmallIconURI != nil {
+			c.SmallIconURI = *body.SmallIconURI
+		}
+		if body.SessionMaxAgeDashboard != nil {
+			c.SessionMaxAgeDashboard = *body.SessionMaxAgeDashboard
+		}
+	default:
+		writeMethodNotAllowed(w)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, c.Corp)
+}
+
+func (s *Server) handleSites(w http.ResponseWriter, r *http.Request, c *corp) {
+	switch r.Method {
+	case "GET":
+		sites := []sigsci.Site{}
+		for _, st := range c.sites {
+			sites = append(sites, st.Site)
+		}
+		sort.Slice(sites, func(i, j int) bool { return sites[i].Name < sites[j].Name })
+		writeJSON(w, http.StatusOK, dataResponse{sites})
+	case "POST":
+		var body sigsci.CreateSiteBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if body.Name == "" {
+			writeError(w, http.StatusBadRequest, "Site name is required")
+			return
+		}
+		if _, ok := c.sites[body.Name]; ok {
+			writeError(w, http.StatusConflict, "Site already exists")
+			return
+		}
+		st := &site{Site: sigsci.Site{
+			Name:                 body.Name,
+			DisplayName:          body.DisplayName,
+			AgentLevel:           body.AgentLevel,
+			BlockHTTPCode:        body.BlockHTTPCode,
+			BlockDurationSeconds: body.BlockDurationSeconds,
+			Created:              time.Now().UTC(),
+		}}
+		c.sites[body.Name] = st
+		writeJSON(w, http.StatusCreated, st.Site)
+	default:
+		writeMethodNotAllowed(w)
+	}
+}
+
+func (s *Server) handleSite(w http.ResponseWriter, r *http.Request, c *corp, st *site) {
+	switch r.Method {
+	case "GET":
+	case "PATCH":
+		var body sigsci.UpdateSiteBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if body.DisplayName != nil {
+			st.DisplayName = *body.DisplayName
+		}
+		if body.AgentLevel != nil {
+			st.AgentLevel = *body.AgentLevel
+		}
+		if body.BlockHTTPCode != nil {
+			st.BlockHTTPCode = *body.BlockHTTPCode
+		}
+		if body.BlockDurationSeconds != nil {
+			st.BlockDurationSeconds = *body.BlockDurationSeconds
+		}
+	case "DELETE":
+		delete(c.sites, st.Name)
+		w.WriteHeader(http.StatusNoContent)
+		return
+	default:
+		writeMethodNotAllowed(w)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, st.Site)
+}
+
+func (s *Server) handleAgents(w http.ResponseWriter, r *http.Request, st *site, name string) {
+	if r.Method != "GET" {
+		writeMethodNotAllowed(w)
+		return
+	}
+
+	if name == "" {
+		writeJSON(w, http.StatusOK, dataResponse{append([]sigsci.Agent{}, st.agents...)})
+		return
+	}
+
+	for _, a := range st.agents {
+		if a.AgentName == name {
+			writeJSON(w, http.StatusOK, a)
+			return
+		}
+	}
+	writeNotFound(w)
+}
+
+func (s *Server) handleEvents(w http.ResponseWriter, r *http.Request, st *site, id string) {
+	if r.Method != "GET" {
+		writeMethodNotAllowed(w)
+		return
+	}
+
+	if id == "" {
+		start, end, next := page(r, len(st.events))
+		writeJSON(w, http.StatusOK, pageResponse{
+			TotalCount: len(st.events),
+			Next:       map[string]string{"uri": next},
+			Data:       append([]sigsci.Event{}, st.events[start:end]...),
+		})
+		return
+	}
+
+	for _, e := range st.events {
+		if e.ID == id {
+			writeJSON(w, http.StatusOK, e)
+			return
+		}
+	}
+	writeNotFound(w)
+}
+
+func (s *Server) handleExpireEvent(w http.ResponseWriter, r *http.Request, st *site, id string) {
+	if r.Method != "POST" {
+		writeMethodNotAllowed(w)
+		return
+	}
+
+	for i, e := range st.events {
+		if e.ID == id {
+			st.events[i].Expires = time.Now().UTC()
+			st.events[i].ExpiredBy = r.Header.Get("X-API-User")
+			writeJSON(w, http.StatusOK, st.events[i])
+			return
+		}
+	}
+	writeNotFound(w)
+}
+
+func (s *Server) handleRequests(w http.ResponseWriter, r *http.Request, st *site, id string) {
+	if r.Method != "GET" {
+		writeMethodNotAllowed(w)
+		return
+	}
+
+	if id == "" {
+		start, end, next := page(r, len(st.requests))
+		writeJSON(w, http.StatusOK, pageResponse{
+			TotalCount: len(st.requests),
+			Next:       map[string]string{"uri": next},
+			Data:       append([]sigsci.Request{}, st.requests[start:end]...),
+		})
+		return
+	}
+
+	for _, req := range st.requests {
+		if req.ID == id {
+			writeJSON(w, http.StatusOK, req)
+			return
+		}
+	}
+	writeNotFound(w)
+}
+
+// handleFeed returns the requests with a timestamp in [from, until).
+func (s *Server) handleFeed(w http.ResponseWriter, r *http.Request, st *site) {
+	if r.Method != "GET" {
+		writeMethodNotAllowed(w)
+		return
+	}
+
+	q := r.URL.Query()
+	from, err := strconv.ParseInt(q.Get("from"), 10, 64)
+	if err != nil || from%60 != 0 {
+		writeError(w, http.StatusBadRequest, "from must be a unix timestamp on a full minute")
+		return
+	}
+	until, err := strconv.ParseInt(q.Get("until"), 10, 64)
+	if err != nil || until%60 != 0 || until <= from {
+		writeError(w, http.StatusBadRequest, "until must be a unix timestamp on a full minute after from")
+		return
+	}
+
+	requests := []sigsci.Request{}
+	for _, req := range st.requests {
+		if ts := req.Timestamp.Unix(); ts >= from && ts < until {
+			requests = append(requests, req)
+		}
+	}
+
+	start, end, next := page(r, len(requests))
+	writeJSON(w, http.StatusOK, pageResponse{
+		Next: map[string]string{"uri": next},
+		Data: requests[start:end],
+	})
+}
+
+func (s *Server) handleIPList(w http.ResponseWriter, r *http.Request, list *[]sigsci.ListIP, id string) {
+	switch {
+	case r.Method == "GET" && id == "":
+		writeJSON(w, http.StatusOK, dataResponse{append([]sigsci.ListIP{}, *list...)})
+	case r.Method == "POST" && id == "":
+		var body struct {
+			Source  string
+			Note    string
+			Expires string
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if body.Source == "" {
+			writeError(w, http.StatusBadRequest, "Source is required")
+			return
+		}
+		ip := sigsci.ListIP{
+			ID:        s.newID(),
+			Source:    body.Source,
+			Note:      body.Note,
+			CreatedBy: r.Header.Get("X-API-User"),
+			Created:   time.Now().UTC(),
+		}
+		if body.Expires != "" {
+			expires, err := time.Parse(time.RFC3339, body.Expires)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, "Invalid expires")
+				return
+			}
+			ip.Expires = expires
+		}
+		*list = append(*list, ip)
+		writeJSON(w, http.StatusOK, ip)
+	case r.Method == "DELETE" && id != "":
+		for i, ip := range *list {
+			if ip.ID == id {
+				*list = append((*list)[:i], (*list)[i+1:]...)
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+		}
+		writeNotFound(w)
+	default:
+		writeMethodNotAllowed(w)
+	}
+}
+
+func (s *Server) handleRedactions(w http.ResponseWriter, r *http.Request, st *site, id string) {
+	if id == "" {
+		switch r.Method {
+		case "GET":
+			writeJSON(w, http.StatusOK, dataResponse{append([]sigsci.Redaction{}, st.redactions...)})
+		case "POST":
+			var body sigsci.RedactionBody
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			rd := sigsci.Redaction{
+				ID:            s.newID(),
+				Field:         body.Field,
+				RedactionType: body.RedactionType,
+				CreatedBy:     r.Header.Get("X-API-User"),
+				Created:       time.Now().UTC(),
+			}
+			st.redactions = append(st.redactions, rd)
+			writeJSON(w, http.StatusOK, dataResponse{[]sigsci.Redaction{rd}})
+		default:
+			writeMethodNotAllowed(w)
+		}
+		return
+	}
+
+	for i := range st.redactions {
+		rd := &st.redactions[i]
+		if rd.ID != id {
+			continue
+		}
+
+		switch r.Method {
+		case "GET":
+			writeJSON(w, http.StatusOK, rd)
+		case "PATCH":
+			var body sigsci.UpdateRedactionBody
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			if body.Field != nil {
+				rd.Field = *body.Field
+			}
+			if body.RedactionType != nil {
+				rd.RedactionType = *body.RedactionType
+			}
+			writeJSON(w, http.StatusOK, rd)
+		case "DELETE":
+			st.redactions = append(st.redactions[:i], st.redactions[i+1:]...)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			writeMethodNotAllowed(w)
+		}
+		return
+	}
+	writeNotFound(w)
+}
+
+func (s *Server) handleIntegrations(w http.ResponseWriter, r *http.Request, st *site, id string) {
+	if id == "" {
+		switch r.Method {
+		case "GET":
+			writeJSON(w, http.StatusOK, dataResponse{append([]sigsci.Integration{}, st.integrations...)})
+		case "POST":
+			var body sigsci.IntegrationBody
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			if err := body.Validate(); err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			in := sigsci.Integration{
+				ID:        s.newID(),
+				Type:      body.Type,
+				URL:       body.URL,
+				Events:    body.Events,
+				Active:    true,
+				CreatedBy: r.Header.Get("X-API-User"),
+				Created:   time.Now().UTC(),
+			}
+			st.integrations = append(st.integrations, in)
+			writeJSON(w, http.StatusOK, dataResponse{[]sigsci.Integration{in}})
+		default:
+			writeMethodNotAllowed(w)
+		}
+		return
+	}
+
+	for i := range st.integrations {
+		in := &st.integrations[i]
+		if in.ID != id {
+			continue
+		}
+
+		switch r.Method {
+		case "GET":
+			writeJSON(w, http.StatusOK, in)
+		case "PATCH":
+			var body sigsci.UpdateIntegrationBody
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			if body.URL != "" {
+				in.URL = body.URL
+			}
+			if body.Events != nil {
+				in.Events = body.Events
+			}
+			w.WriteHeader(http.StatusNoContent)
+		case "DELETE":
+			st.integrations = append(st.integrations[:i], st.integrations[i+1:]...)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			writeMethodNotAllowed(w)
+		}
+		return
+	}
+	writeNotFound(w)
+}
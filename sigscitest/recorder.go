@@ -0,0 +1,306 @@
+package sigscitest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// Mode is the mode of a Recorder.
+type Mode int
+
+// All available Modes
+const (
+	// Replay answers requests from the interactions in the cassette file,
+	// without using the network.
+	Replay Mode = iota
+	// Record sends requests to the real API and records the interactions,
+	// to be written to the cassette file by Save.
+	Record
+)
+
+// Redacted replaces secrets in recorded interactions.
+const Redacted = "REDACTED"
+
+// Interaction is a recorded request and its response.
+type Interaction struct {
+	Request  InteractionRequest  `json:"request"`
+	Response InteractionResponse `json:"response"`
+}
+
+// InteractionRequest is a recorded request. Path is the URL path and
+// Query the encoded query, with its parameters sorted by key.
+type InteractionRequest struct {
+	Method string      `json:"method"`
+	Path   string      `json:"path"`
+	Query  string      `json:"query,omitempty"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// InteractionResponse is a recorded response.
+type InteractionResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+type cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Recorder is an http.RoundTripper that records interactions with the API
+// to a JSON cassette file and replays them, for integration tests that run
+// without network access. Use it with sigsci.WithTransport:
+//
+//	rec, err := sigscitest.NewRecorder("testdata/sites.json", sigscitest.Replay)
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	sc := sigsci.NewTokenClient(email, token, sigsci.WithTransport(rec))
+//
+// Secrets are scrubbed before interactions are recorded: the X-API-Token,
+// Authorization, Cookie and Set-Cookie headers, the password sent to
+// /v0/auth, and the token in responses such as those of /v0/auth and
+// CreateAPIToken.
+//
+// In Replay mode, requests are matched to the recorded interactions by
+// method, path, query and body, and each interaction is used once. A
+// request with no matching interaction fails with an error.
+type Recorder struct {
+	// Transport sends requests in Record mode. If nil,
+	// http.DefaultTransport is used.
+	Transport http.RoundTripper
+	// Scrub, if not nil, is called on each interaction after the default
+	// scrubbing, to remove other secrets before it is recorded. In Replay
+	// mode it is called on each request, with an empty response, so that
+	// requests match the scrubbed interactions.
+	Scrub func(*Interaction)
+
+	path string
+	mode Mode
+
+	mu           sync.Mutex
+	interactions []Interaction
+	used         []bool
+}
+
+// NewRecorder returns a Recorder for the cassette file at path. In Replay
+// mode the file is read, and in Record mode it is written by Save.
+func NewRecorder(path string, mode Mode) (*Recorder, error) {
+	r := &Recorder{path: path, mode: mode}
+	if mode == Record {
+		return r, nil
+	}
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var c cassette
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, fmt.Errorf("sigscitest: reading cassette %s: %v", path, err)
+	}
+	r.interactions = c.Interactions
+	r.used = make([]bool, len(c.Interactions))
+
+	return r, nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ir := InteractionRequest{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  req.URL.Query().Encode(),
+		Header: cloneHeader(req.Header),
+		Body:   string(body),
+	}
+	scrubRequest(&ir)
+
+	if r.mode == Record {
+		return r.record(req, ir, body)
+	}
+
+	if r.Scrub != nil {
+		in := Interaction{Request: ir}
+		r.Scrub(&in)
+		ir = in.Request
+	}
+
+	return r.replay(req, ir)
+}
+
+func (r *Recorder) record(req *http.Request, ir InteractionRequest, body []byte) (*http.Response, error) {
+	req = req.WithContext(req.Context())
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	t := r.Transport
+	if t == nil {
+		t = http.DefaultTransport
+	}
+	resp, err := t.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	in := Interaction{
+		Request: ir,
+		Response: InteractionResponse{
+			StatusCode: resp.StatusCode,
+			Header:     cloneHeader(resp.Header),
+			Body:       string(respBody),
+		},
+	}
+	scrubResponse(&in)
+	if r.Scrub != nil {
+		r.Scrub(&in)
+	}
+
+	r.mu.Lock()
+	r.interactions = append(r.interactions, in)
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, ir InteractionRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, in := range r.interactions {
+		if r.used[i] || !matches(in.Request, ir) {
+			continue
+		}
+		r.used[i] = true
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", in.Response.StatusCode, http.StatusText(in.Response.StatusCode)),
+			StatusCode:    in.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        cloneHeader(in.Response.Header),
+			Body:          ioutil.NopCloser(bytes.NewReader([]byte(in.Response.Body))),
+			ContentLength: int64(len(in.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	msg := fmt.Sprintf("sigscitest: no recorded interaction in %s for %s %s", r.path, ir.Method, ir.Path)
+	if ir.Query != "" {
+		msg += "?" + ir.Query
+	}
+	if ir.Body != "" {
+		msg += " with body " + ir.Body
+	}
+
+	return nil, errors.New(msg)
+}
+
+func matches(recorded, req InteractionRequest) bool {
+	return recorded.Method == req.Method &&
+		recorded.Path == req.Path &&
+		recorded.Query == req.Query &&
+		recorded.Body == req.Body
+}
+
+// Unused returns the recorded interactions that have not been replayed.
+func (r *Recorder) Unused() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var unused []Interaction
+	for i, in := range r.interactions {
+		if i < len(r.used) && !r.used[i] {
+			unused = append(unused, in)
+		}
+	}
+
+	return unused
+}
+
+// Save writes the recorded interactions to the cassette file. It does
+// nothing in Replay mode.
+func (r *Recorder) Save() error {
+	if r.mode != Record {
+		return nil
+	}
+
+	r.mu.Lock()
+	c := cassette{Interactions: append([]Interaction{}, r.interactions...)}
+	r.mu.Unlock()
+
+	b, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(r.path, append(b, '\n'), 0644)
+}
+
+var secretHeaders = []string{"X-API-Token", "Authorization", "Cookie", "Set-Cookie"}
+
+func scrubHeader(h http.Header) {
+	for _, k := range secretHeaders {
+		if h.Get(k) != "" {
+			h.Set(k, Redacted)
+		}
+	}
+}
+
+func scrubRequest(ir *InteractionRequest) {
+	scrubHeader(ir.Header)
+
+	if strings.HasSuffix(ir.Path, "/v0/auth") && ir.Body != "" {
+		if form, err := url.ParseQuery(ir.Body); err == nil && form.Get("password") != "" {
+			form.Set("password", Redacted)
+			ir.Body = form.Encode()
+		}
+	}
+}
+
+func scrubResponse(in *Interaction) {
+	scrubHeader(in.Response.Header)
+
+	var v map[string]interface{}
+	if err := json.Unmarshal([]byte(in.Response.Body), &v); err != nil {
+		return
+	}
+	var scrubbed bool
+	for k := range v {
+		if strings.EqualFold(k, "token") {
+			v[k] = Redacted
+			scrubbed = true
+		}
+	}
+	if !scrubbed {
+		return
+	}
+	if b, err := json.Marshal(v); err == nil {
+		in.Response.Body = string(b)
+	}
+}
@@ -0,0 +1,78 @@
+package sigscitest
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	sigsci "github.com/signalsciences/go-sigsci"
+)
+
+func TestRecorder(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sigscitest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "cassette.json")
+
+	srv := NewServer()
+	srv.AddSite("testcorp", sigsci.Site{Name: "www.mysite.com"})
+
+	rec, err := NewRecorder(path, Record)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sc, err := sigsci.NewClient("test@sigscitest.local", "secret-password",
+		sigsci.WithBaseURL(srv.URL), sigsci.WithTransport(rec))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sc.ListSites("testcorp"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sc.AddWhitelistIP("testcorp", "www.mysite.com", sigsci.ListIPBody{Source: "10.0.0.1"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := rec.Save(); err != nil {
+		t.Fatal(err)
+	}
+	srv.Close()
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"secret-password", Token} {
+		if strings.Contains(string(b), secret) {
+			t.Errorf("cassette contains secret %q:\n%s", secret, b)
+		}
+	}
+
+	rec, err = NewRecorder(path, Replay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sc, err = sigsci.NewClient("test@sigscitest.local", "another-password",
+		sigsci.WithBaseURL(srv.URL), sigsci.WithTransport(rec))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sites, err := sc.ListSites("testcorp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sites) != 1 || sites[0].Name != "www.mysite.com" {
+		t.Errorf("got sites %+v", sites)
+	}
+	if n := len(rec.Unused()); n != 1 {
+		t.Errorf("got %d unused interactions, want 1", n)
+	}
+
+	_, err = sc.AddWhitelistIP("testcorp", "www.mysite.com", sigsci.ListIPBody{Source: "10.0.0.2"})
+	if err == nil || !strings.Contains(err.Error(), "no recorded interaction") {
+		t.Errorf("got %v for unmatched request, want no recorded interaction error", err)
+	}
+}
@@ -0,0 +1,399 @@
+// Package sigscitest provides an in-memory fake of the Signal Sciences API
+// for testing code that uses the sigsci package.
+//
+// A Server keeps corps, sites, agents, events, requests, whitelisted and
+// blacklisted IPs, redactions and integrations in memory, and records every
+// request it receives. Faults such as latency or 429 and 500 responses can
+// be injected to test retries and error handling.
+//
+//	srv := sigscitest.NewServer()
+//	defer srv.Close()
+//
+//	srv.AddCorp(sigsci.Corp{Name: "testcorp"})
+//	srv.AddSite("testcorp", sigsci.Site{Name: "www.mysite.com"})
+//
+//	sc := srv.NewClient()
+//	sites, err := sc.ListSites("testcorp")
+//
+// A Recorder records interactions with the real API to a cassette file,
+// with secrets scrubbed, and replays them in tests without network access.
+package sigscitest
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	sigsci "github.com/signalsciences/go-sigsci"
+)
+
+// Token is the session token returned by the fake authentication endpoint.
+const Token = "sigscitest-token"
+
+// Server is a fake Signal Sciences API server.
+type Server struct {
+	// URL is the base URL of the API, for use with sigsci.WithBaseURL.
+	URL string
+
+	ts *httptest.Server
+
+	mu       sync.Mutex
+	corps    map[string]*corp
+	faults   []*Fault
+	requests []RecordedRequest
+	lastID   int
+
+	sessionExpired bool
+}
+
+type corp struct {
+	sigsci.Corp
+	sites map[string]*site
+}
+
+type site struct {
+	sigsci.Site
+	agents       []sigsci.Agent
+	events       []sigsci.Event
+	requests     []sigsci.Request
+	whitelist    []sigsci.ListIP
+	blacklist    []sigsci.ListIP
+	redactions   []sigsci.Redaction
+	integrations []sigsci.Integration
+}
+
+// NewServer starts and returns a new Server. The caller should call Close
+// when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		corps: map[string]*corp{},
+	}
+	s.ts = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.ts.URL + "/api"
+
+	return s
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.ts.Close()
+}
+
+// NewClient returns a token client for the server. Additional options are
+// applied after the base URL option.
+func (s *Server) NewClient(opts ...sigsci.ClientOption) sigsci.Client {
+	opts = append([]sigsci.ClientOption{sigsci.WithBaseURL(s.URL)}, opts...)
+
+	return sigsci.NewTokenClient("test@sigscitest.local", Token, opts...)
+}
+
+// AddCorp adds a corp, replacing any corp with the same name.
+func (s *Server) AddCorp(c sigsci.Corp) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if c.Created.IsZero() {
+		c.Created = time.Now().UTC()
+	}
+	s.corps[c.Name] = &corp{Corp: c, sites: map[string]*site{}}
+}
+
+// AddSite adds a site to a corp, adding the corp if it does not exist.
+func (s *Server) AddSite(corpName string, st sigsci.Site) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.corps[corpName]
+	if !ok {
+		c = &corp{Corp: sigsci.Corp{Name: corpName, Created: time.Now().UTC()}, sites: map[string]*site{}}
+		s.corps[corpName] = c
+	}
+	if st.Created.IsZero() {
+		st.Created = time.Now().UTC()
+	}
+	c.sites[st.Name] = &site{Site: st}
+}
+
+// AddAgents adds agents to a site. It panics if the site does not exist.
+func (s *Server) AddAgents(corpName, siteName string, agents ...sigsci.Agent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st := s.mustSite(corpName, siteName)
+	st.agents = append(st.agents, agents...)
+}
+
+// AddEvents adds events to a site. Events without an ID are assigned one.
+// It panics if the site does not exist.
+func (s *Server) AddEvents(corpName, siteName string, events ...sigsci.Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st := s.mustSite(corpName, siteName)
+	for _, e := range events {
+		if e.ID == "" {
+			e.ID = s.newID()
+		}
+		st.events = append(st.events, e)
+	}
+}
+
+// AddRequests adds requests to a site, which are returned by request
+// searches and, by timestamp, by the request feed. Requests without an ID
+// are assigned one. It panics if the site does not exist.
+func (s *Server) AddRequests(corpName, siteName string, requests ...sigsci.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st := s.mustSite(corpName, siteName)
+	for _, r := range requests {
+		if r.ID == "" {
+			r.ID = s.newID()
+		}
+		st.requests = append(st.requests, r)
+	}
+}
+
+func (s *Server) mustSite(corpName, siteName string) *site {
+	c, ok := s.corps[corpName]
+	if !ok {
+		panic("sigscitest: unknown corp " + corpName)
+	}
+	st, ok := c.sites[siteName]
+	if !ok {
+		panic("sigscitest: unknown site " + siteName)
+	}
+
+	return st
+}
+
+// Fault makes the server delay or fail matching requests.
+type Fault struct {
+	// Method and Path select the requests the fault applies to. An empty
+	// Method matches all methods, and Path matches all API paths it is a
+	// prefix of, e.g. "/v0/corps/testcorp/sites". An empty Path matches
+	// all paths.
+	Method string
+	Path   string
+	// Latency delays matching requests.
+	Latency time.Duration
+	// Status, if not zero, is returned instead of handling the request,
+	// e.g. http.StatusTooManyRequests or http.StatusInternalServerError.
+	Status int
+	// RetryAfter, if not empty, is sent as the Retry-After header of
+	// failed requests.
+	RetryAfter string
+	// Times limits the fault to the first Times matching requests. Zero
+	// applies it to all matching requests.
+	Times int
+}
+
+// InjectFault adds a fault. Faults are applied in the order they were
+// added and the first matching one is used.
+func (s *Server) InjectFault(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = append(s.faults, &f)
+}
+
+// ClearFaults removes all faults.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = nil
+}
+
+// fault returns the fault for a request, if any, and counts it.
+func (s *Server) fault(method, path string) (Fault, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, f := range s.faults {
+		if f.Method != "" && f.Method != method {
+			continue
+		}
+		if !strings.HasPrefix(path, f.Path) {
+			continue
+		}
+
+		if f.Times > 0 {
+			f.Times--
+			if f.Times == 0 {
+				s.faults = append(s.faults[:i:i], s.faults[i+1:]...)
+			}
+		}
+
+		return *f, true
+	}
+
+	return Fault{}, false
+}
+
+// RecordedRequest is a request received by the server.
+type RecordedRequest struct {
+	Method string
+	// Path is the API path without the /api prefix, e.g. "/v0/corps".
+	Path   string
+	Query  string
+	Header http.Header
+	Body   []byte
+}
+
+// Requests returns the requests received by the server, in order.
+func (s *Server) Requests() []RecordedRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]RecordedRequest(nil), s.requests...)
+}
+
+// ResetRequests forgets the requests received so far.
+func (s *Server) ResetRequests() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = nil
+}
+
+// RequestCount returns the number of requests received with the given
+// method and API path.
+func (s *Server) RequestCount(method, path string) int {
+	var n int
+	for _, r := range s.Requests() {
+		if r.Method == method && r.Path == path {
+			n++
+		}
+	}
+
+	return n
+}
+
+// TB is the subset of testing.TB used by the assertion methods.
+type TB interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+}
+
+// AssertRequested reports an error if no request was received with the
+// given method and API path.
+func (s *Server) AssertRequested(t TB, method, path string) {
+	t.Helper()
+
+	if s.RequestCount(method, path) == 0 {
+		t.Errorf("sigscitest: expected request %s %s, got none", method, path)
+	}
+}
+
+// AssertNotRequested reports an error if a request was received with the
+// given method and API path.
+func (s *Server) AssertNotRequested(t TB, method, path string) {
+	t.Helper()
+
+	if n := s.RequestCount(method, path); n > 0 {
+		t.Errorf("sigscitest: expected no request %s %s, got %d", method, path, n)
+	}
+}
+
+// AssertRequestCount reports an error unless exactly n requests were
+// received with the given method and API path.
+func (s *Server) AssertRequestCount(t TB, method, path string, n int) {
+	t.Helper()
+
+	if got := s.RequestCount(method, path); got != n {
+		t.Errorf("sigscitest: expected %d requests %s %s, got %d", n, method, path, got)
+	}
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := ioutil.ReadAll(r.Body)
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+	path := strings.TrimPrefix(r.URL.Path, "/api")
+
+	s.mu.Lock()
+	s.requests = append(s.requests, RecordedRequest{
+		Method: r.Method,
+		Path:   path,
+		Query:  r.URL.RawQuery,
+		Header: cloneHeader(r.Header),
+		Body:   body,
+	})
+	s.mu.Unlock()
+
+	if f, ok := s.fault(r.Method, path); ok {
+		if f.Latency > 0 {
+			select {
+			case <-time.After(f.Latency):
+			case <-r.Context().Done():
+				return
+			}
+		}
+		if f.Status != 0 {
+			if f.RetryAfter != "" {
+				w.Header().Set("Retry-After", f.RetryAfter)
+			}
+			writeError(w, f.Status, http.StatusText(f.Status))
+			return
+		}
+	}
+
+	if path == "/v0/auth" {
+		s.handleAuth(w, r)
+		return
+	}
+
+	if !s.authenticated(r) {
+		writeError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	if path == "/v0/auth/logout" {
+		s.handleLogout(w, r)
+		return
+	}
+
+	s.route(w, r, path)
+}
+
+func (s *Server) authenticated(r *http.Request) bool {
+	if r.Header.Get("X-API-User") != "" && r.Header.Get("X-API-Token") != "" {
+		return true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return !s.sessionExpired && r.Header.Get("Authorization") == "Bearer "+Token
+}
+
+// ExpireSession makes the server reject the session token returned by the
+// authentication endpoint until the next login, to test clients created
+// with sigsci.NewClient logging in again.
+func (s *Server) ExpireSession() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sessionExpired = true
+}
+
+func cloneHeader(h http.Header) http.Header {
+	c := make(http.Header, len(h))
+	for k, v := range h {
+		c[k] = append([]string(nil), v...)
+	}
+
+	return c
+}
+
+// newID returns a new unique ID. s.mu must be held.
+func (s *Server) newID() string {
+	s.lastID++
+
+	return fmt.Sprintf("%024x", s.lastID)
+}
@@ -0,0 +1,159 @@
+package sigscitest
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	sigsci "github.com/signalsciences/go-sigsci"
+)
+
+func TestServer(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	srv.AddSite("testcorp", sigsci.Site{Name: "www.mysite.com", BlockDurationSeconds: 86400})
+	srv.AddAgents("testcorp", "www.mysite.com", sigsci.Agent{AgentName: "agent-1", AgentActive: true})
+
+	sc := srv.NewClient()
+
+	site, err := sc.UpdateSite("testcorp", "www.mysite.com", sigsci.UpdateSiteBody{BlockDurationSeconds: sigsci.Int(0)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if site.BlockDurationSeconds != 0 {
+		t.Errorf("got block duration %d, want 0", site.BlockDurationSeconds)
+	}
+
+	agent, err := sc.GetAgent("testcorp", "www.mysite.com", "agent-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !agent.AgentActive {
+		t.Errorf("got inactive agent %+v", agent)
+	}
+
+	ip, err := sc.AddWhitelistIP("testcorp", "www.mysite.com", sigsci.ListIPBody{Source: "10.0.0.1", Note: "office"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sc.DeleteWhitelistIP("testcorp", "www.mysite.com", ip.ID); err != nil {
+		t.Fatal(err)
+	}
+	ips, err := sc.ListWhitelistIPs("testcorp", "www.mysite.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ips) != 0 {
+		t.Errorf("got whitelist %+v after delete, want empty", ips)
+	}
+
+	_, err = sc.GetSite("testcorp", "www.othersite.com")
+	if !sigsci.IsNotFound(err) {
+		t.Errorf("got %v, want not found", err)
+	}
+
+	srv.AssertRequested(t, "PATCH", "/v0/corps/testcorp/sites/www.mysite.com")
+	srv.AssertRequestCount(t, "DELETE", "/v0/corps/testcorp/sites/www.mysite.com/whitelist/"+ip.ID, 1)
+	srv.AssertNotRequested(t, "DELETE", "/v0/corps/testcorp/sites/www.mysite.com")
+}
+
+func TestServerPagination(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	srv.AddSite("testcorp", sigsci.Site{Name: "www.mysite.com"})
+	start := time.Unix(1500000000, 0)
+	for i := 0; i < 5; i++ {
+		srv.AddRequests("testcorp", "www.mysite.com", sigsci.Request{Timestamp: start.Add(time.Duration(i) * time.Minute)})
+	}
+
+	sc := srv.NewClient()
+	it := sc.SearchRequestsIterator("testcorp", "www.mysite.com", url.Values{"limit": {"2"}})
+	var n int
+	for it.Next() {
+		n++
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if n != 5 || it.TotalCount() != 5 {
+		t.Errorf("got %d requests and total count %d, want 5", n, it.TotalCount())
+	}
+	srv.AssertRequestCount(t, "GET", "/v0/corps/testcorp/sites/www.mysite.com/requests", 3)
+
+	feed := url.Values{
+		"from":  {"1500000000"},
+		"until": {"1500000120"},
+	}
+	_, requests, err := sc.GetRequestFeed("testcorp", "www.mysite.com", feed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(requests) != 2 {
+		t.Errorf("got %d feed requests, want 2", len(requests))
+	}
+}
+
+func TestServerFaults(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	srv.AddCorp(sigsci.Corp{Name: "testcorp"})
+	srv.InjectFault(Fault{Method: "GET", Path: "/v0/corps", Status: http.StatusTooManyRequests, RetryAfter: "0", Times: 2})
+
+	var retries int
+	sc := srv.NewClient(sigsci.WithRetryPolicy(sigsci.RetryPolicy{
+		MaxAttempts: 3,
+		OnRetry:     func(sigsci.RetryInfo) { retries++ },
+	}))
+
+	corps, err := sc.ListCorps()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(corps) != 1 || retries != 2 {
+		t.Errorf("got %d corps after %d retries, want 1 after 2", len(corps), retries)
+	}
+
+	srv.InjectFault(Fault{Status: http.StatusInternalServerError})
+	sc = srv.NewClient()
+	_, err = sc.GetCorp("testcorp")
+	if !sigsci.IsServerError(err) {
+		t.Errorf("got %v, want server error", err)
+	}
+
+	srv.ClearFaults()
+	srv.InjectFault(Fault{Latency: 50 * time.Millisecond})
+	sc = srv.NewClient(sigsci.WithTimeout(10 * time.Millisecond))
+	_, err = sc.GetCorp("testcorp")
+	if err == nil {
+		t.Error("expected timeout error")
+	}
+}
+
+func TestServerExpireSession(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	srv.AddCorp(sigsci.Corp{Name: "testcorp"})
+
+	sc, err := sigsci.NewClient("test@sigscitest.local", "password", sigsci.WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv.ExpireSession()
+	if _, err := sc.GetCorp("testcorp"); err != nil {
+		t.Fatal(err)
+	}
+	srv.AssertRequestCount(t, "POST", "/v0/auth", 2)
+
+	if err := sc.Logout(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sc.GetCorp("testcorp"); !sigsci.IsUnauthorized(err) {
+		t.Errorf("got %v after logout, want unauthorized", err)
+	}
+}
@@ -0,0 +1,165 @@
+package sigsci
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// TemplatedRule contains the configuration of a templated rule, such as
+// the login attempt, success and failure rules used for account takeover
+// protection.
+type TemplatedRule struct {
+	// Name is the name of the template, e.g. "LOGINATTEMPT".
+	Name       string
+	Detections []Detection
+	Alerts     []CustomAlert
+}
+
+// Detection is a configured detection of a templated rule, which adds the
+// template's signal to requests matching its fields.
+type Detection struct {
+	ID        string
+	Name      string
+	Enabled   bool
+	Fields    []DetectionField
+	CreatedBy string
+	Created   time.Time
+}
+
+// DetectionField is a field of a detection, e.g. the path of a login
+// endpoint.
+type DetectionField struct {
+	Name  string      `json:"name"`
+	Value interface{} `json:"value"`
+}
+
+// templatedRulesResponse is the response for the list templated rules
+// endpoint.
+type templatedRulesResponse struct {
+	TotalCount int
+	Data       []TemplatedRule
+}
+
+// DetectionBody is the body for adding a detection.
+type DetectionBody struct {
+	Name    string           `json:"name"`
+	Enabled bool             `json:"enabled"`
+	Fields  []DetectionField `json:"fields"`
+}
+
+// UpdateDetectionBody is the body for updating a detection. Only the
+// fields that are set are changed.
+type UpdateDetectionBody struct {
+	ID      string           `json:"id"`
+	Name    *string          `json:"name,omitempty"`
+	Enabled *bool            `json:"enabled,omitempty"`
+	Fields  []DetectionField `json:"fields,omitempty"`
+}
+
+// TemplatedRuleAlertBody is the body for adding an alert to a templated
+// rule. Its thresholds and action are those of a custom alert.
+type TemplatedRuleAlertBody struct {
+	CustomAlertBody
+	SkipNotifications bool `json:"skipNotifications"`
+}
+
+// UpdateTemplatedRuleAlertBody is the body for updating an alert of a
+// templated rule. Only the fields that are set are changed.
+type UpdateTemplatedRuleAlertBody struct {
+	ID                string  `json:"id"`
+	LongName          *string `json:"longName,omitempty"`
+	Interval          *int    `json:"interval,omitempty"`
+	Threshold         *int    `json:"threshold,omitempty"`
+	Enabled           *bool   `json:"enabled,omitempty"`
+	Action            *string `json:"action,omitempty"`
+	SkipNotifications *bool   `json:"skipNotifications,omitempty"`
+}
+
+// TemplatedRuleDeleteBody identifies a detection or alert to delete from a
+// templated rule.
+type TemplatedRuleDeleteBody struct {
+	ID string `json:"id"`
+}
+
+// UpdateTemplatedRuleBody is the body for updating the detections and
+// alerts of a templated rule.
+type UpdateTemplatedRuleBody struct {
+	DetectionAdds    []DetectionBody                `json:"detectionAdds,omitempty"`
+	DetectionUpdates []UpdateDetectionBody          `json:"detectionUpdates,omitempty"`
+	DetectionDeletes []TemplatedRuleDeleteBody      `json:"detectionDeletes,omitempty"`
+	AlertAdds        []TemplatedRuleAlertBody       `json:"alertAdds,omitempty"`
+	AlertUpdates     []UpdateTemplatedRuleAlertBody `json:"alertUpdates,omitempty"`
+	AlertDeletes     []TemplatedRuleDeleteBody      `json:"alertDeletes,omitempty"`
+}
+
+// ListTemplatedRules lists the templated rules available for a site with
+// their current configuration.
+func (sc *Client) ListTemplatedRules(corpName, siteName string) ([]TemplatedRule, error) {
+	return sc.ListTemplatedRulesContext(context.Background(), corpName, siteName)
+}
+
+// ListTemplatedRulesContext is like ListTemplatedRules but uses the given context.
+func (sc *Client) ListTemplatedRulesContext(ctx context.Context, corpName, siteName string) ([]TemplatedRule, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/sites/%s/configuredtemplatedrules", corpName, siteName), "")
+	if err != nil {
+		return []TemplatedRule{}, err
+	}
+
+	var tr templatedRulesResponse
+	err = json.Unmarshal(resp, &tr)
+	if err != nil {
+		return []TemplatedRule{}, err
+	}
+
+	return tr.Data, nil
+}
+
+// GetTemplatedRule gets the configuration of a templated rule by name.
+func (sc *Client) GetTemplatedRule(corpName, siteName, name string) (TemplatedRule, error) {
+	return sc.GetTemplatedRuleContext(context.Background(), corpName, siteName, name)
+}
+
+// GetTemplatedRuleContext is like GetTemplatedRule but uses the given context.
+func (sc *Client) GetTemplatedRuleContext(ctx context.Context, corpName, siteName, name string) (TemplatedRule, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/sites/%s/configuredtemplatedrules/%s", corpName, siteName, name), "")
+	if err != nil {
+		return TemplatedRule{}, err
+	}
+
+	var t TemplatedRule
+	err = json.Unmarshal(resp, &t)
+	if err != nil {
+		return TemplatedRule{}, err
+	}
+
+	return t, nil
+}
+
+// UpdateTemplatedRule adds, updates and deletes detections and alerts of
+// a templated rule by name.
+func (sc *Client) UpdateTemplatedRule(corpName, siteName, name string, body UpdateTemplatedRuleBody) (TemplatedRule, error) {
+	return sc.UpdateTemplatedRuleContext(context.Background(), corpName, siteName, name, body)
+}
+
+// UpdateTemplatedRuleContext is like UpdateTemplatedRule but uses the given context.
+func (sc *Client) UpdateTemplatedRuleContext(ctx context.Context, corpName, siteName, name string, body UpdateTemplatedRuleBody) (TemplatedRule, error) {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return TemplatedRule{}, err
+	}
+
+	resp, err := sc.doRequest(ctx, "POST", fmt.Sprintf("/v0/corps/%s/sites/%s/configuredtemplatedrules/%s", corpName, siteName, name), string(b))
+	if err != nil {
+		return TemplatedRule{}, err
+	}
+
+	var t TemplatedRule
+	err = json.Unmarshal(resp, &t)
+	if err != nil {
+		return TemplatedRule{}, err
+	}
+
+	return t, nil
+}
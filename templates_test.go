@@ -0,0 +1,102 @@
+package sigsci
+
+import "testing"
+
+func TestTemplatedRules(t *testing.T) {
+	rule := TemplatedRule{
+		Name: "LOGINATTEMPT",
+		Detections: []Detection{{
+			ID:      "d1",
+			Name:    "path",
+			Enabled: true,
+			Fields:  []DetectionField{{Name: "path", Value: "/login"}},
+		}},
+		Alerts: []CustomAlert{{
+			ID:        "a1",
+			TagName:   "LOGINATTEMPT",
+			Interval:  1,
+			Threshold: 10,
+			Enabled:   true,
+			Action:    "flagged",
+		}},
+	}
+	response := `{
+		"name": "LOGINATTEMPT",
+		"detections": [{"id": "d1", "name": "path", "enabled": true, "fields": [{"name": "path", "value": "/login"}]}],
+		"alerts": [{"id": "a1", "tagName": "LOGINATTEMPT", "interval": 1, "threshold": 10, "enabled": true, "action": "flagged"}]
+	}`
+
+	runRequestTests(t, []requestTest{
+		{
+			name: "list",
+			call: func(sc *Client) (interface{}, error) {
+				return sc.ListTemplatedRules("testcorp", "www.mysite.com")
+			},
+			method:   "GET",
+			path:     "/v0/corps/testcorp/sites/www.mysite.com/configuredtemplatedrules",
+			response: `{"totalCount":1,"data":[` + response + `]}`,
+			want:     []TemplatedRule{rule},
+		},
+		{
+			name: "get",
+			call: func(sc *Client) (interface{}, error) {
+				return sc.GetTemplatedRule("testcorp", "www.mysite.com", "LOGINATTEMPT")
+			},
+			method:   "GET",
+			path:     "/v0/corps/testcorp/sites/www.mysite.com/configuredtemplatedrules/LOGINATTEMPT",
+			response: response,
+			want:     rule,
+		},
+		{
+			name: "add alert",
+			call: func(sc *Client) (interface{}, error) {
+				return sc.UpdateTemplatedRule("testcorp", "www.mysite.com", "LOGINATTEMPT", UpdateTemplatedRuleBody{
+					AlertAdds: []TemplatedRuleAlertBody{{
+						CustomAlertBody: CustomAlertBody{
+							TagName:   "LOGINATTEMPT",
+							LongName:  "Login attempts",
+							Interval:  10,
+							Threshold: 50,
+							Enabled:   true,
+							Action:    "blocked",
+						},
+						SkipNotifications: true,
+					}},
+				})
+			},
+			method:   "POST",
+			path:     "/v0/corps/testcorp/sites/www.mysite.com/configuredtemplatedrules/LOGINATTEMPT",
+			body:     `{"alertAdds":[{"tagName":"LOGINATTEMPT","longName":"Login attempts","interval":10,"threshold":50,"enabled":true,"action":"blocked","skipNotifications":true}]}`,
+			response: response,
+			want:     rule,
+		},
+		{
+			name: "update alert",
+			call: func(sc *Client) (interface{}, error) {
+				return sc.UpdateTemplatedRule("testcorp", "www.mysite.com", "LOGINATTEMPT", UpdateTemplatedRuleBody{
+					AlertUpdates: []UpdateTemplatedRuleAlertBody{{ID: "a1", Threshold: Int(20), Enabled: Bool(false)}},
+				})
+			},
+			method:   "POST",
+			path:     "/v0/corps/testcorp/sites/www.mysite.com/configuredtemplatedrules/LOGINATTEMPT",
+			body:     `{"alertUpdates":[{"id":"a1","threshold":20,"enabled":false}]}`,
+			response: response,
+			want:     rule,
+		},
+		{
+			name: "update and delete detections",
+			call: func(sc *Client) (interface{}, error) {
+				return sc.UpdateTemplatedRule("testcorp", "www.mysite.com", "LOGINATTEMPT", UpdateTemplatedRuleBody{
+					DetectionUpdates: []UpdateDetectionBody{{ID: "d1", Fields: []DetectionField{{Name: "path", Value: "/signin"}}}},
+					DetectionDeletes: []TemplatedRuleDeleteBody{{ID: "d2"}},
+					AlertDeletes:     []TemplatedRuleDeleteBody{{ID: "a2"}},
+				})
+			},
+			method:   "POST",
+			path:     "/v0/corps/testcorp/sites/www.mysite.com/configuredtemplatedrules/LOGINATTEMPT",
+			body:     `{"detectionUpdates":[{"id":"d1","fields":[{"name":"path","value":"/signin"}]}],"detectionDeletes":[{"id":"d2"}],"alertDeletes":[{"id":"a2"}]}`,
+			response: response,
+			want:     rule,
+		},
+	})
+}
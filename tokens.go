@@ -0,0 +1,226 @@
+package sigsci
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// APIToken contains the data for an API access token.
+type APIToken struct {
+	ID   string
+	Name string
+	// Token is the secret used with NewTokenClient. It is only returned
+	// by CreateAPIToken.
+	Token       string
+	Fingerprint string
+	Created     time.Time
+	CreatedBy   string
+	LastUsedAt  time.Time
+	Expires     time.Time
+}
+
+// apiTokensResponse is the response for the list API tokens endpoints.
+type apiTokensResponse struct {
+	Data []APIToken
+}
+
+// CreateAPITokenBody is the body for creating an API token.
+type CreateAPITokenBody struct {
+	Name string `json:"name"`
+}
+
+// ListAPITokens lists the API tokens of the current user.
+func (sc *Client) ListAPITokens() ([]APIToken, error) {
+	return sc.ListAPITokensContext(context.Background())
+}
+
+// ListAPITokensContext is like ListAPITokens but uses the given context.
+func (sc *Client) ListAPITokensContext(ctx context.Context) ([]APIToken, error) {
+	resp, err := sc.doRequest(ctx, "GET", "/v0/users/self/tokens", "")
+	if err != nil {
+		return []APIToken{}, err
+	}
+
+	var tr apiTokensResponse
+	err = json.Unmarshal(resp, &tr)
+	if err != nil {
+		return []APIToken{}, err
+	}
+
+	return tr.Data, nil
+}
+
+// CreateAPIToken creates an API token for the current user. The returned
+// token is the only one to include the token secret.
+func (sc *Client) CreateAPIToken(body CreateAPITokenBody) (APIToken, error) {
+	return sc.CreateAPITokenContext(context.Background(), body)
+}
+
+// CreateAPITokenContext is like CreateAPIToken but uses the given context.
+func (sc *Client) CreateAPITokenContext(ctx context.Context, body CreateAPITokenBody) (APIToken, error) {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return APIToken{}, err
+	}
+
+	resp, err := sc.doRequest(ctx, "POST", "/v0/users/self/tokens", string(b))
+	if err != nil {
+		return APIToken{}, err
+	}
+
+	var t APIToken
+	err = json.Unmarshal(resp, &t)
+	if err != nil {
+		return APIToken{}, err
+	}
+
+	return t, nil
+}
+
+// GetAPIToken gets an API token of the current user by id.
+func (sc *Client) GetAPIToken(id string) (APIToken, error) {
+	return sc.GetAPITokenContext(context.Background(), id)
+}
+
+// GetAPITokenContext is like GetAPIToken but uses the given context.
+func (sc *Client) GetAPITokenContext(ctx context.Context, id string) (APIToken, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/users/self/tokens/%s", id), "")
+	if err != nil {
+		return APIToken{}, err
+	}
+
+	var t APIToken
+	err = json.Unmarshal(resp, &t)
+	if err != nil {
+		return APIToken{}, err
+	}
+
+	return t, nil
+}
+
+// DeleteAPIToken revokes an API token of the current user by id.
+func (sc *Client) DeleteAPIToken(id string) error {
+	return sc.DeleteAPITokenContext(context.Background(), id)
+}
+
+// DeleteAPITokenContext is like DeleteAPIToken but uses the given context.
+func (sc *Client) DeleteAPITokenContext(ctx context.Context, id string) error {
+	_, err := sc.doRequest(ctx, "DELETE", fmt.Sprintf("/v0/users/self/tokens/%s", id), "")
+
+	return err
+}
+
+// ListCorpUserAPITokens lists the API tokens of a corp user. It requires
+// a corp owner.
+func (sc *Client) ListCorpUserAPITokens(corpName, email string) ([]APIToken, error) {
+	return sc.ListCorpUserAPITokensContext(context.Background(), corpName, email)
+}
+
+// ListCorpUserAPITokensContext is like ListCorpUserAPITokens but uses the given context.
+func (sc *Client) ListCorpUserAPITokensContext(ctx context.Context, corpName, email string) ([]APIToken, error) {
+	resp, err := sc.doRequest(ctx, "GET", fmt.Sprintf("/v0/corps/%s/users/%s/tokens", corpName, email), "")
+	if err != nil {
+		return []APIToken{}, err
+	}
+
+	var tr apiTokensResponse
+	err = json.Unmarshal(resp, &tr)
+	if err != nil {
+		return []APIToken{}, err
+	}
+
+	return tr.Data, nil
+}
+
+// DeleteCorpUserAPIToken revokes an API token of a corp user by id. It
+// requires a corp owner.
+func (sc *Client) DeleteCorpUserAPIToken(corpName, email, id string) error {
+	return sc.DeleteCorpUserAPITokenContext(context.Background(), corpName, email, id)
+}
+
+// DeleteCorpUserAPITokenContext is like DeleteCorpUserAPIToken but uses the given context.
+func (sc *Client) DeleteCorpUserAPITokenContext(ctx context.Context, corpName, email, id string) error {
+	_, err := sc.doRequest(ctx, "DELETE", fmt.Sprintf("/v0/corps/%s/users/%s/tokens/%s", corpName, email, id), "")
+
+	return err
+}
+
+// RotateTokenError is returned by RotateToken when verifying the new
+// token or revoking the old one fails.
+type RotateTokenError struct {
+	// Op is the step that failed, "verifying new token" or "revoking old
+	// token".
+	Op string
+	// Token is the new token, including its secret, if it is still valid.
+	// It is zero if the new token was revoked after a failed verification.
+	Token APIToken
+	// Err is the error of the failed step.
+	Err error
+	// RevokeErr is the error revoking the new token after a failed
+	// verification, if any.
+	RevokeErr error
+}
+
+func (e *RotateTokenError) Error() string {
+	if e.RevokeErr != nil {
+		return fmt.Sprintf("%s: %v (revoking it also failed: %v)", e.Op, e.Err, e.RevokeErr)
+	}
+
+	return fmt.Sprintf("%s: %v", e.Op, e.Err)
+}
+
+// Unwrap returns the error of the failed step, so that helpers such as
+// IsUnauthorized apply to it.
+func (e *RotateTokenError) Unwrap() error {
+	return e.Err
+}
+
+// RotateToken creates a new API token with the given name for the current
+// user, whose email is required to authenticate with it, verifies the new
+// token with a test call and then revokes the token with id oldID. If the
+// verification fails, the new token is revoked and the old one is kept.
+//
+// Errors after the new token was created are of type *RotateTokenError.
+// If revoking the old token fails, RotateToken returns the new token
+// together with the error, as both tokens remain valid and the secret of
+// the new one cannot be retrieved again; it is also set in the error.
+//
+// The client itself is not changed; callers that authenticate with the
+// old token should create a new client with the returned token.
+func (sc *Client) RotateToken(email, name, oldID string) (APIToken, error) {
+	return sc.RotateTokenContext(context.Background(), email, name, oldID)
+}
+
+// RotateTokenContext is like RotateToken but uses the given context.
+func (sc *Client) RotateTokenContext(ctx context.Context, email, name, oldID string) (APIToken, error) {
+	t, err := sc.CreateAPITokenContext(ctx, CreateAPITokenBody{Name: name})
+	if err != nil {
+		return APIToken{}, err
+	}
+
+	nc := *sc
+	nc.email = email
+	nc.token = t.Token
+	nc.session = nil
+
+	_, err = nc.ListCorpsContext(ctx)
+	if err != nil {
+		rerr := &RotateTokenError{Op: "verifying new token", Err: err}
+		if derr := sc.DeleteAPITokenContext(ctx, t.ID); derr != nil {
+			rerr.Token = t
+			rerr.RevokeErr = derr
+		}
+		return APIToken{}, rerr
+	}
+
+	// Revoke the old token with the new one, as the old token may be the
+	// one sc authenticates with.
+	err = nc.DeleteAPITokenContext(ctx, oldID)
+	if err != nil {
+		return t, &RotateTokenError{Op: "revoking old token", Token: t, Err: err}
+	}
+
+	return t, nil
+}
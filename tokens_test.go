@@ -0,0 +1,68 @@
+package sigsci
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRotateToken(t *testing.T) {
+	var deleted []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/v0/users/self/tokens":
+			w.Write([]byte(`{"id":"new","name":"rotated","token":"newsecret"}`))
+		case r.Method == "GET" && r.URL.Path == "/v0/corps":
+			if r.Header.Get("X-API-Token") != "newsecret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Write([]byte(`{"data":[]}`))
+		case r.Method == "DELETE":
+			deleted = append(deleted, r.URL.Path+" "+r.Header.Get("X-API-Token"))
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer ts.Close()
+
+	sc := NewTokenClient("test@test.net", "oldsecret", WithBaseURL(ts.URL))
+	tok, err := sc.RotateToken("test@test.net", "rotated", "old")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tok.Token != "newsecret" {
+		t.Errorf("got token %q, want newsecret", tok.Token)
+	}
+	if len(deleted) != 1 || deleted[0] != "/v0/users/self/tokens/old newsecret" {
+		t.Errorf("got deletes %v, want old token revoked with the new one", deleted)
+	}
+}
+
+func TestRotateTokenRevokeFails(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST":
+			w.Write([]byte(`{"id":"new","name":"rotated","token":"newsecret"}`))
+		case r.Method == "GET":
+			w.Write([]byte(`{"data":[]}`))
+		case r.Method == "DELETE":
+			w.WriteHeader(http.StatusForbidden)
+		}
+	}))
+	defer ts.Close()
+
+	sc := NewTokenClient("test@test.net", "oldsecret", WithBaseURL(ts.URL))
+	tok, err := sc.RotateToken("test@test.net", "rotated", "old")
+	if !IsForbidden(err) {
+		t.Fatalf("got %v, want forbidden", err)
+	}
+	rerr, ok := err.(*RotateTokenError)
+	if !ok {
+		t.Fatalf("got %T, want *RotateTokenError", err)
+	}
+	if tok.Token != "newsecret" || rerr.Token.Token != "newsecret" {
+		t.Errorf("got tokens %q and %q, want newsecret", tok.Token, rerr.Token.Token)
+	}
+}